@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func benchCmd(args []string) error {
+	if len(args) > 0 && args[0] == "compare" {
+		return benchCompareCmd(args[1:])
+	}
+
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to benchmark, all days if unset")
+	part := fs.Int("part", 0, "part to benchmark (1 or 2), both if unset")
+	runs := fs.Int("runs", 20, "number of times to run each phase")
+	example := fs.Bool("example", false, "use each day's test-input instead of input")
+	format := fs.String("format", aoc.FormatText, "report format: text, markdown or json")
+	record := fs.Bool("record", true, "append the results to the bench history, keyed by git commit")
+	recordDirty := fs.Bool("record-dirty", false, "record even if the working tree has uncommitted changes")
+	fs.Parse(args)
+
+	days := aoc.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	var results []aoc.BenchResult
+	for _, d := range days {
+		puzzle, ok := aoc.Get(d)
+		if !ok {
+			return fmt.Errorf("day %d is not registered", d)
+		}
+		example, err := exampleIfLocked(d, "", *example)
+		if err != nil {
+			return err
+		}
+		path, err := utils.InputPath(d, "", example)
+		if err != nil {
+			return err
+		}
+		input, err := utils.ReadInput(path)
+		if errors.Is(err, os.ErrNotExist) && *day == 0 {
+			fmt.Fprintf(os.Stderr, "skipping day %d: %v\n", d, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		timings, err := puzzle.Bench(input, *runs, parts)
+		if err != nil {
+			return fmt.Errorf("day %d: %w", d, err)
+		}
+		results = append(results, aoc.BenchResult{Day: d, Input: filepath.Base(path), Timings: timings})
+	}
+
+	if *record && len(results) > 0 {
+		if err := recordHistory(results, *recordDirty); err != nil {
+			return fmt.Errorf("recording bench history: %w", err)
+		}
+	}
+	return aoc.WriteReport(os.Stdout, results, *format)
+}
+
+func historyPath() (string, error) {
+	root, err := utils.RepoRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, aoc.HistoryFile), nil
+}
+
+// recordHistory appends results to the history under HEAD. Runs on a dirty tree aren't of HEAD's
+// code, so they're only recorded (and marked Dirty) if allowDirty is set.
+func recordHistory(results []aoc.BenchResult, allowDirty bool) error {
+	commit, err := resolveCommit("HEAD")
+	if err != nil {
+		return err
+	}
+	dirty, err := isDirty()
+	if err != nil {
+		return err
+	}
+	if dirty && !allowDirty {
+		fmt.Fprintln(os.Stderr, "not recording, the working tree has uncommitted changes. use -record-dirty to record anyway.")
+		return nil
+	}
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var records []aoc.HistoryRecord
+	for _, r := range results {
+		for _, t := range r.Timings {
+			records = append(records, aoc.HistoryRecord{
+				Time: now, Commit: commit, Dirty: dirty, Day: r.Day, Input: r.Input, Timing: t,
+			})
+		}
+	}
+	return aoc.AppendHistory(path, records)
+}
+
+func benchCompareCmd(args []string) error {
+	fs := flag.NewFlagSet("bench compare", flag.ExitOnError)
+	threshold := fs.Float64("threshold", 10, "flag phases that got more than this percentage slower")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc bench compare [-threshold percent] <revA> <revB>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errors.New("bench compare needs two revisions")
+	}
+
+	a, err := resolveCommit(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := resolveCommit(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+	history, err := aoc.LoadHistory(path)
+	if err != nil {
+		return err
+	}
+
+	comparisons := aoc.Compare(history, a, b, *threshold/100)
+	if len(comparisons) == 0 {
+		return fmt.Errorf("no phases were benchmarked at both %s and %s, run aoc bench at each", fs.Arg(0), fs.Arg(1))
+	}
+	if err := aoc.WriteComparison(os.Stdout, comparisons, shortCommit(a), shortCommit(b)); err != nil {
+		return err
+	}
+
+	var slower int
+	for _, c := range comparisons {
+		if c.Slower {
+			slower++
+		}
+	}
+	if slower > 0 {
+		return fmt.Errorf("%d phases got more than %g%% slower", slower, *threshold)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package main
+
+// Each day registers itself with the aoc package when imported
+import (
+	_ "github.com/josiemessa/aoc2025/day1"
+	_ "github.com/josiemessa/aoc2025/day2"
+	_ "github.com/josiemessa/aoc2025/day3"
+	_ "github.com/josiemessa/aoc2025/day4"
+	_ "github.com/josiemessa/aoc2025/day5"
+)
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/puzzle"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func examplesCmd(args []string) error {
+	fs := flag.NewFlagSet("examples", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to write the examples for")
+	list := fs.Bool("list", false, "only list the page's code blocks and the guessed examples")
+	force := fs.Bool("force", false, "overwrite example inputs and answers that already exist")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc examples -day N [-list] [-force] page.html")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("examples needs a saved puzzle page")
+	}
+
+	b, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	page := puzzle.ParsePage(string(b))
+	if len(page.Articles) == 0 {
+		return fmt.Errorf("%s has no puzzle description, save the page while logged in", fs.Arg(0))
+	}
+	examples := page.Examples()
+
+	if *list {
+		return listBlocks(page, examples)
+	}
+	if *day == 0 {
+		return fmt.Errorf("-day is required")
+	}
+	if len(examples) == 0 {
+		return fmt.Errorf("no example found in %s, use -list to see its code blocks", fs.Arg(0))
+	}
+
+	dir, err := utils.DayDir(*day)
+	if err != nil {
+		return err
+	}
+	answers, err := aoc.LoadAnswers(dir)
+	if err != nil {
+		return err
+	}
+	for i, ex := range examples {
+		name := aoc.ExampleName(i + 1)
+		path := filepath.Join(dir, name)
+		// aoc new leaves a blank test-input to be filled in, so that isn't kept
+		exists, err := utils.InputExists(path)
+		if err != nil {
+			return err
+		}
+		if err := writeNew(path, []byte(ex.Input), *force || !exists); err != nil {
+			return err
+		}
+		// the page's answers are only for its example, so they're left alone if a different
+		// input was kept. A missing final newline doesn't make it different.
+		if b, err := os.ReadFile(path); err != nil {
+			return err
+		} else if strings.TrimRight(string(b), "\n") != strings.TrimRight(ex.Input, "\n") {
+			fmt.Fprintf(os.Stderr, "skip %s answers (%s differs from the page's example)\n", name, name)
+			continue
+		}
+
+		expected := answers[name]
+		for part := 1; part <= 2; part++ {
+			answer := ex.Answers[part-1]
+			if answer == "" {
+				continue
+			}
+			if old := expected.Part(part); old != "" && old != answer && !*force {
+				fmt.Fprintf(os.Stderr, "skip %s part %d answer %s (have %s). use -force to overwrite.\n", name, part, answer, old)
+				continue
+			}
+			expected.Set(part, answer)
+			fmt.Printf("%s part %d: %s\n", name, part, answer)
+		}
+		answers[name] = expected
+	}
+	return answers.Save(dir)
+}
+
+// listBlocks prints every code block on the page, marking the ones guessed to be examples
+func listBlocks(page puzzle.Page, examples []puzzle.Example) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "part\tblock\tlines\texample\tfirst line")
+	for _, a := range page.Articles {
+		for i, block := range a.Blocks {
+			example := ""
+			for n, ex := range examples {
+				if ex.Input == block {
+					example = aoc.ExampleName(n + 1)
+				}
+			}
+			first, _, _ := strings.Cut(block, "\n")
+			fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%.40s\n", a.Part, i, strings.Count(strings.TrimSuffix(block, "\n"), "\n")+1, example, first)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for n, ex := range examples {
+		fmt.Printf("%s answers: part 1 %q, part 2 %q\n", aoc.ExampleName(n+1), ex.Answers[0], ex.Answers[1])
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/client"
+	"github.com/josiemessa/aoc2025/pkg/config"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to fetch, every registered day without an input if unset")
+	force := fs.Bool("force", false, "download the input again even if it's already cached")
+	fs.Parse(args)
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	days := aoc.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+	ctx := context.Background()
+	for _, d := range days {
+		path, err := utils.InputPath(d, "", false)
+		if err != nil {
+			return err
+		}
+		if *force {
+			input, err := c.Input(ctx, d)
+			if err != nil {
+				return fmt.Errorf("day %d: %w", d, err)
+			}
+			if err := os.WriteFile(path, input, 0o644); err != nil {
+				return err
+			}
+			fmt.Println("fetched", path)
+			continue
+		}
+
+		fetched, err := c.CacheInput(ctx, d, path)
+		if errors.Is(err, client.ErrNotUnlocked) && *day == 0 {
+			fmt.Fprintf(os.Stderr, "skipping day %d: %v\n", d, err)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("day %d: %w", d, err)
+		}
+		if fetched {
+			fmt.Println("fetched", path)
+		} else {
+			fmt.Println("cached", path)
+		}
+	}
+	return nil
+}
+
+// newClient returns a client for the site set in the config
+func newClient() (*client.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	c := client.New(cfg.BaseURL, cfg.Year, cfg.Session)
+	c.Contact = cfg.Contact
+	return c, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// git runs a git command in the repo, returning its trimmed output
+func git(args ...string) (string, error) {
+	out, err := exec.Command("git", args...).Output()
+	if err != nil {
+		if exit, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), strings.TrimSpace(string(exit.Stderr)))
+		}
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// resolveCommit turns a revision such as HEAD~1 or a branch name into a full commit hash
+func resolveCommit(rev string) (string, error) {
+	return git("rev-parse", "--verify", rev+"^{commit}")
+}
+
+// isDirty reports whether the working tree has uncommitted changes
+func isDirty() (bool, error) {
+	out, err := git("status", "--porcelain")
+	return out != "", err
+}
+
+func shortCommit(commit string) string {
+	return commit[:min(len(commit), 12)]
+}
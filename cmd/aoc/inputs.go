@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func inputsCmd(args []string) error {
+	fs := flag.NewFlagSet("inputs", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to act on, every registered day if unset")
+	force := fs.Bool("force", false, "decrypt over an input that differs from its encrypted copy")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), `usage: aoc inputs encrypt|decrypt|status [-day N] [-force]
+
+  encrypt  write each day's input to input.enc, to be committed in its place
+  decrypt  write each day's input from input.enc
+  status   show which inputs are plain, encrypted, or out of sync`)
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		return errors.New("inputs needs an action")
+	}
+	action := args[0]
+	fs.Parse(args[1:])
+
+	days := aoc.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+	switch action {
+	case "encrypt":
+		return encryptInputs(days)
+	case "decrypt":
+		return decryptInputs(days, *force)
+	case "status":
+		return inputsStatus(days)
+	}
+	fs.Usage()
+	return fmt.Errorf("unknown inputs action %q", action)
+}
+
+// inputKey is utils.InputKey, suggesting a new key if there isn't one
+func inputKey() ([]byte, error) {
+	key, err := utils.InputKey()
+	if errors.Is(err, utils.ErrNoInputKey) {
+		return nil, fmt.Errorf("no input key, set AOC_INPUT_KEY or input_key in the config file, e.g. to this new one:\n\t%s", utils.NewInputKey())
+	}
+	return key, err
+}
+
+func encryptInputs(days []int) error {
+	key, err := inputKey()
+	if err != nil {
+		return err
+	}
+	for _, d := range days {
+		path, err := utils.InputPath(d, "", false)
+		if err != nil {
+			return err
+		}
+		input, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) || err == nil && len(input) == 0 {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		enc, err := utils.Encrypt(key, input)
+		if err != nil {
+			return err
+		}
+		if old, err := os.ReadFile(path + utils.EncryptedSuffix); err == nil && bytes.Equal(old, enc) {
+			fmt.Println("unchanged", path+utils.EncryptedSuffix)
+			continue
+		}
+		if err := os.WriteFile(path+utils.EncryptedSuffix, enc, 0o644); err != nil {
+			return err
+		}
+		fmt.Println("encrypted", path+utils.EncryptedSuffix)
+	}
+	return nil
+}
+
+func decryptInputs(days []int, force bool) error {
+	key, err := inputKey()
+	if err != nil {
+		return err
+	}
+	for _, d := range days {
+		path, err := utils.InputPath(d, "", false)
+		if err != nil {
+			return err
+		}
+		enc, err := os.ReadFile(path + utils.EncryptedSuffix)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		input, err := utils.Decrypt(key, enc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path+utils.EncryptedSuffix, err)
+		}
+
+		old, err := os.ReadFile(path)
+		switch {
+		case err == nil && bytes.Equal(old, input):
+			fmt.Println("unchanged", path)
+			continue
+		case err == nil && len(old) > 0 && !force:
+			fmt.Fprintf(os.Stderr, "skip %s (differs from %s). use -force to overwrite.\n", path, filepath.Base(path+utils.EncryptedSuffix))
+			continue
+		case err != nil && !errors.Is(err, fs.ErrNotExist):
+			return err
+		}
+		if err := os.WriteFile(path, input, 0o644); err != nil {
+			return err
+		}
+		fmt.Println("decrypted", path)
+	}
+	return nil
+}
+
+func inputsStatus(days []int) error {
+	// status still shows what's there without a key, it just can't compare the two copies
+	key, keyErr := utils.InputKey()
+	if keyErr != nil && !errors.Is(keyErr, utils.ErrNoInputKey) {
+		return keyErr
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "day\tinput\tinput.enc\tstatus")
+	for _, d := range days {
+		path, err := utils.InputPath(d, "", false)
+		if err != nil {
+			return err
+		}
+		input, inputErr := os.ReadFile(path)
+		enc, encErr := os.ReadFile(path + utils.EncryptedSuffix)
+		for _, err := range []error{inputErr, encErr} {
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+		if inputErr == nil && len(input) == 0 {
+			inputErr = fs.ErrNotExist // an empty placeholder, as for utils.InputExists
+		}
+
+		var status string
+		switch {
+		case inputErr != nil && encErr != nil:
+			status = "missing, use aoc fetch"
+		case encErr != nil:
+			status = "not encrypted, use aoc inputs encrypt"
+		case keyErr != nil:
+			status = "no key"
+		case inputErr != nil:
+			status = "encrypted only, use aoc inputs decrypt"
+		default:
+			plain, err := utils.Decrypt(key, enc)
+			switch {
+			case err != nil:
+				status = err.Error()
+			case bytes.Equal(plain, input):
+				status = "ok"
+			default:
+				status = "differs, use aoc inputs encrypt or decrypt -force"
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", d, present(inputErr), present(encErr), status)
+	}
+	return w.Flush()
+}
+
+func present(err error) string {
+	if err != nil {
+		return "-"
+	}
+	return "yes"
+}
+
+// exampleIfLocked is example, or true if the day's real input is only there encrypted and
+// there's no key to read it, so commands fall back to the examples rather than failing
+func exampleIfLocked(day int, path string, example bool) (bool, error) {
+	if example || path != "" {
+		return example, nil
+	}
+	path, err := utils.InputPath(day, "", false)
+	if err != nil {
+		return false, err
+	}
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+		return false, nil
+	}
+	if info, err := os.Stat(path + utils.EncryptedSuffix); err != nil || info.Size() == 0 {
+		return false, nil
+	}
+	if _, err := utils.InputKey(); errors.Is(err, utils.ErrNoInputKey) {
+		fmt.Fprintf(os.Stderr, "day %d: %v, using the example instead\n", day, err)
+		return true, nil
+	}
+	return false, nil
+}
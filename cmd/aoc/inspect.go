@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func inspectCmd(args []string) error {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+	day := fs.Int("day", 0, "inspect this day's input rather than a file")
+	example := fs.Bool("example", false, "inspect the day's test-input instead of input")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc inspect <file>\n       aoc inspect -day N [-example]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	var path string
+	switch {
+	case fs.NArg() == 1 && *day == 0:
+		path = fs.Arg(0)
+	case fs.NArg() == 0 && *day != 0:
+		var err error
+		if path, err = utils.InputPath(*day, "", *example); err != nil {
+			return err
+		}
+	default:
+		fs.Usage()
+		return errors.New("inspect needs either a file or -day")
+	}
+
+	var input []byte
+	var err error
+	if path == utils.Stdin {
+		input, err = io.ReadAll(os.Stdin)
+	} else {
+		input, err = utils.ReadInput(path)
+	}
+	if err != nil {
+		return err
+	}
+	parse.Inspect(input).Format(os.Stdout)
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/josiemessa/aoc2025/pkg/logging"
+)
+
+// logFlags are the verbosity flags shared by the commands that run solutions
+type logFlags struct {
+	v, vv bool
+	spec  string
+}
+
+func (l *logFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&l.v, "v", false, "log at debug level")
+	fs.BoolVar(&l.vv, "vv", false, "log at trace level, which includes every step of the solutions' loops")
+	fs.StringVar(&l.spec, "log", "", "log level per component, e.g. slowgraph=debug,day4=trace")
+}
+
+func (l *logFlags) levels() (logging.Levels, error) {
+	levels := logging.Levels{Default: slog.LevelInfo}
+	switch {
+	case l.vv:
+		levels.Default = logging.LevelTrace
+	case l.v:
+		levels.Default = slog.LevelDebug
+	}
+	if err := levels.ParseLevels(l.spec); err != nil {
+		return logging.Levels{}, fmt.Errorf("-log: %w", err)
+	}
+	return levels, nil
+}
+
+// logger returns a logger writing to w at the levels set by the flags
+func (l *logFlags) logger(w io.Writer) (*slog.Logger, error) {
+	levels, err := l.levels()
+	if err != nil {
+		return nil, err
+	}
+	return logging.New(w, levels), nil
+}
+
+// dayLogger returns the logger for day's solution
+func dayLogger(l *slog.Logger, day int) *slog.Logger {
+	return logging.Component(l, fmt.Sprintf("day%d", day))
+}
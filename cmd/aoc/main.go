@@ -0,0 +1,87 @@
+// Command aoc runs any registered Advent of Code solution.
+//
+//	aoc run -day 4 -part 2
+//	aoc run -day 4 -example
+//	aoc run -day 4 -input path/to/input
+//	aoc run -day 4 - < input
+//	aoc run -day 4 -part 2 -cpuprofile -memprofile
+//	aoc run -day 4 -timeout 10s -memlimit 2GiB
+//	aoc run -all -example
+//	aoc run -day 4 -v -log=slowgraph=debug,day4=trace
+//	aoc verify -day 4
+//	aoc watch -day 4
+//	aoc bench -format markdown
+//	aoc bench compare HEAD~1 HEAD
+//	aoc inspect day6/input
+//	aoc new -day 6 -template grid
+//	aoc examples -day 6 ~/Downloads/day6.html
+//	AOC_SESSION=... aoc fetch -day 6
+//	aoc submit -day 6 -part 1
+//	AOC_INPUT_KEY=... aoc inputs encrypt
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: aoc <command> [flags]
+
+commands:
+  run       solve a day's puzzle
+  verify    check solutions against the stored answers
+  watch     rebuild and verify a day whenever its code or inputs change
+  bench     time each day's parse and parts, or compare timings between commits
+  inspect   summarise an input's shape: widths, sections, chars, integers and line patterns
+  new       create a new day from a template
+  examples  write a day's example inputs and answers from a saved puzzle page
+  fetch     download puzzle inputs
+  submit    send a part's answer and record the verdict
+  inputs    encrypt, decrypt or show the status of the committed puzzle inputs`)
+}
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCmd(os.Args[2:])
+	case "verify":
+		err = verifyCmd(os.Args[2:])
+	case "watch":
+		err = watchCmd(os.Args[2:])
+	case "bench":
+		err = benchCmd(os.Args[2:])
+	case "inspect":
+		err = inspectCmd(os.Args[2:])
+	case "new":
+		err = newCmd(os.Args[2:])
+	case "examples":
+		err = examplesCmd(os.Args[2:])
+	case "fetch":
+		err = fetchCmd(os.Args[2:])
+	case "submit":
+		err = submitCmd(os.Args[2:])
+	case "inputs":
+		err = inputsCmd(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+//go:embed templates/*.go.tmpl
+var templates embed.FS
+
+// archetypes are the kinds of input a new day can be started from, each with its own template
+var archetypes = []string{"lines", "grid", "ranges", "sections"}
+
+func newCmd(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to create, the day after the latest existing one if unset")
+	archetype := fs.String("template", "lines", "kind of input to start from: "+strings.Join(archetypes, ", "))
+	force := fs.Bool("force", false, "overwrite files that already exist")
+	fs.Parse(args)
+
+	root, err := utils.RepoRoot()
+	if err != nil {
+		return err
+	}
+	if *day == 0 {
+		if *day, err = nextDay(root); err != nil {
+			return err
+		}
+	}
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("day %d is not between 1 and 25", *day)
+	}
+	if !slices.Contains(archetypes, *archetype) {
+		return fmt.Errorf("unknown template %q, want one of %s", *archetype, strings.Join(archetypes, ", "))
+	}
+
+	pkg := fmt.Sprintf("day%d", *day)
+	dir := filepath.Join(root, pkg)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data := struct {
+		Day     int
+		Package string
+	}{*day, pkg}
+
+	solver, err := render(*archetype+".go.tmpl", data)
+	if err != nil {
+		return err
+	}
+	test, err := render("test.go.tmpl", data)
+	if err != nil {
+		return err
+	}
+	// a blank entry for the first example, ready to fill in
+	answers, err := aoc.Answers{utils.ExampleFile: {}}.Marshal()
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{pkg + ".go", solver},
+		{pkg + "_test.go", test},
+		{aoc.AnswersFile, answers},
+		// left empty to paste the example into. There's no input placeholder, aoc fetch writes it.
+		{utils.ExampleFile, nil},
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := writeNew(path, f.content, *force); err != nil {
+			return err
+		}
+	}
+	return registerDay(filepath.Join(root, "cmd", "aoc", "days.go"), pkg)
+}
+
+// nextDay is the day after the latest day directory in root
+func nextDay(root string) (int, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "day*"))
+	if err != nil {
+		return 0, err
+	}
+	latest := 0
+	for _, d := range dirs {
+		n, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(d), "day"))
+		if err == nil && n > latest {
+			latest = n
+		}
+	}
+	return latest + 1, nil
+}
+
+func render(name string, data any) ([]byte, error) {
+	t, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return src, nil
+}
+
+// writeNew writes content to path, skipping it if it already exists unless force is set
+func writeNew(path string, content []byte, force bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !force {
+		flags |= os.O_EXCL
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		fmt.Fprintf(os.Stderr, "skip %s (exists). use -force to overwrite.\n", path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	fmt.Println("created", path)
+	return f.Close()
+}
+
+var importPattern = regexp.MustCompile(`(?m)^\t_ "github.com/josiemessa/aoc2025/day(\d+)"\n`)
+
+// registerDay adds a blank import of pkg to days.go, in day order, so the runner includes it
+func registerDay(path, pkg string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	line := fmt.Sprintf("\t_ \"github.com/josiemessa/aoc2025/%s\"\n", pkg)
+	if bytes.Contains(src, []byte(line)) {
+		return nil
+	}
+
+	day, _ := strconv.Atoi(strings.TrimPrefix(pkg, "day"))
+	// insert before the first import of a later day, or after the last import
+	at := -1
+	for _, m := range importPattern.FindAllSubmatchIndex(src, -1) {
+		n, _ := strconv.Atoi(string(src[m[2]:m[3]]))
+		if n > day {
+			at = m[0]
+			break
+		}
+		at = m[1]
+	}
+	if at < 0 {
+		return fmt.Errorf("%s: no day imports found to add %s to", path, pkg)
+	}
+	src = append(src[:at:at], append([]byte(line), src[at:]...)...)
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		return err
+	}
+	fmt.Println("registered", pkg, "in", path)
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextDay(t *testing.T) {
+	root := t.TempDir()
+	day, err := nextDay(root)
+	require.NoError(t, err)
+	require.Equal(t, 1, day)
+
+	for _, name := range []string{"day1", "day10", "day2", "dayx", "pkg"} {
+		require.NoError(t, os.Mkdir(filepath.Join(root, name), 0o755))
+	}
+	day, err = nextDay(root)
+	require.NoError(t, err)
+	require.Equal(t, 11, day)
+}
+
+func TestRegisterDay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "days.go")
+	src := `package main
+
+import (
+	_ "github.com/josiemessa/aoc2025/day2"
+	_ "github.com/josiemessa/aoc2025/day10"
+)
+`
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+
+	for _, pkg := range []string{"day1", "day5", "day12", "day5"} {
+		require.NoError(t, registerDay(path, pkg))
+	}
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `package main
+
+import (
+	_ "github.com/josiemessa/aoc2025/day1"
+	_ "github.com/josiemessa/aoc2025/day2"
+	_ "github.com/josiemessa/aoc2025/day5"
+	_ "github.com/josiemessa/aoc2025/day10"
+	_ "github.com/josiemessa/aoc2025/day12"
+)
+`, string(b))
+
+	require.NoError(t, os.WriteFile(path, []byte("package main\n"), 0o644))
+	require.ErrorContains(t, registerDay(path, "day1"), "no day imports")
+}
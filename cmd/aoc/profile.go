@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+// profiler writes CPU, memory, trace and block profiles of each part as it's solved, so
+// reading and parsing the input isn't counted
+type profiler struct {
+	cpu, mem, trace, block bool
+	dir                    string
+}
+
+func (p *profiler) register(fs *flag.FlagSet) {
+	fs.BoolVar(&p.cpu, "cpuprofile", false, "write a CPU profile of each part")
+	fs.BoolVar(&p.mem, "memprofile", false, "write a memory allocation profile of each part")
+	fs.BoolVar(&p.trace, "trace", false, "write an execution trace of each part")
+	fs.BoolVar(&p.block, "blockprofile", false, "write a goroutine blocking profile of each part")
+	fs.StringVar(&p.dir, "profiledir", "", "directory for profiles (default .aoc/profiles in the repo root)")
+}
+
+func (p *profiler) enabled() bool {
+	return p.cpu || p.mem || p.trace || p.block
+}
+
+// profileFiles creates the files for one part's profiles, all sharing a name prefix
+type profileFiles struct {
+	prefix string
+	files  []*os.File
+}
+
+func (pf *profileFiles) create(suffix string) (*os.File, error) {
+	f, err := os.Create(pf.prefix + suffix)
+	if err == nil {
+		pf.files = append(pf.files, f)
+	}
+	return f, err
+}
+
+func (pf *profileFiles) closeAll() error {
+	var errs []error
+	for _, f := range pf.files {
+		errs = append(errs, f.Close())
+		fmt.Fprintln(os.Stderr, "wrote", f.Name())
+	}
+	return errors.Join(errs...)
+}
+
+// start begins profiling phase of day, e.g. part2 or part1/naive. The returned stop function
+// must be called once the part is solved, and writes the profiles to files named like
+// day4-part2-20251204T093000-cpu.pprof.
+//
+// The memory and block profiles count everything since the process started, including reading
+// the input and any earlier parts, so a -base snapshot of each is written when the phase starts.
+// Viewing the profile with pprof -base shows only the phase, plus writing the base itself under
+// profiler.start.
+func (p *profiler) start(day int, phase string) (stop func() error, err error) {
+	if !p.enabled() {
+		return func() error { return nil }, nil
+	}
+
+	dir := p.dir
+	if dir == "" {
+		root, err := utils.RepoRoot()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(root, ".aoc", "profiles")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	pf := &profileFiles{
+		prefix: filepath.Join(dir, fmt.Sprintf("day%d-%s-%s", day, strings.ReplaceAll(phase, "/", "-"), time.Now().Format("20060102T150405"))),
+	}
+
+	// taken before the CPU profile and trace start, so writing them isn't counted either
+	if p.mem {
+		runtime.GC()
+		if err := writeProfile(pf, "allocs", "-mem-base.pprof"); err != nil {
+			return nil, errors.Join(err, pf.closeAll())
+		}
+	}
+	if p.block {
+		if err := writeProfile(pf, "block", "-block-base.pprof"); err != nil {
+			return nil, errors.Join(err, pf.closeAll())
+		}
+	}
+
+	if p.cpu {
+		f, err := pf.create("-cpu.pprof")
+		if err == nil {
+			err = pprof.StartCPUProfile(f)
+		}
+		if err != nil {
+			return nil, errors.Join(err, pf.closeAll())
+		}
+	}
+	if p.trace {
+		f, err := pf.create("-trace.out")
+		if err == nil {
+			err = trace.Start(f)
+		}
+		if err != nil {
+			if p.cpu {
+				pprof.StopCPUProfile()
+			}
+			return nil, errors.Join(err, pf.closeAll())
+		}
+	}
+	if p.block {
+		runtime.SetBlockProfileRate(1)
+	}
+
+	return func() error {
+		var errs []error
+		if p.trace {
+			trace.Stop()
+		}
+		if p.cpu {
+			pprof.StopCPUProfile()
+		}
+		if p.mem {
+			// make sure the part's allocations have made it into the profile
+			runtime.GC()
+			errs = append(errs, writeProfile(pf, "allocs", "-mem.pprof"))
+		}
+		if p.block {
+			errs = append(errs, writeProfile(pf, "block", "-block.pprof"))
+			runtime.SetBlockProfileRate(0)
+		}
+		errs = append(errs, pf.closeAll())
+		for _, k := range []struct {
+			kind string
+			on   bool
+		}{{"mem", p.mem}, {"block", p.block}} {
+			if k.on {
+				fmt.Fprintf(os.Stderr, "view with: go tool pprof -base %s-%s-base.pprof %s-%s.pprof\n", pf.prefix, k.kind, pf.prefix, k.kind)
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+func writeProfile(pf *profileFiles, name, suffix string) error {
+	f, err := pf.create(suffix)
+	if err != nil {
+		return err
+	}
+	return pprof.Lookup(name).WriteTo(f, 0)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to run")
+	all := fs.Bool("all", false, "run every day in parallel and print a table of the results")
+	workers := fs.Int("workers", runtime.GOMAXPROCS(0), "number of parts to solve at once with -all")
+	part := fs.Int("part", 0, "part to run (1 or 2), both if unset")
+	variant := fs.String("variant", "", "run only this implementation of each part, all of them if unset")
+	inputPath := fs.String("input", "", "read input from this file, or - for stdin")
+	example := fs.Bool("example", false, "use the day's test-input instead of input")
+	timeout := fs.Duration("timeout", time.Minute, "stop a part that runs longer than this, 0 for no limit")
+	memLimit := fs.String("memlimit", "", "soft memory limit for a part, e.g. 2GiB, unset for no limit")
+	var logs logFlags
+	logs.register(fs)
+	var prof profiler
+	prof.register(fs)
+	fs.Parse(args)
+	if fs.Arg(0) == utils.Stdin {
+		*inputPath = utils.Stdin
+	}
+	limits := aoc.Limits{Timeout: *timeout}
+	if *memLimit != "" {
+		var err error
+		if limits.Memory, err = aoc.ParseBytes(*memLimit); err != nil {
+			return err
+		}
+	}
+	if *all {
+		levels, err := logs.levels()
+		if err != nil {
+			return err
+		}
+		return runAll(*workers, *example, levels, limits)
+	}
+	logger, err := logs.logger(os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	puzzle, ok := aoc.Get(*day)
+	if !ok {
+		return fmt.Errorf("day %d is not registered", *day)
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	if *example, err = exampleIfLocked(*day, *inputPath, *example); err != nil {
+		return err
+	}
+	name, err := utils.InputPath(*day, *inputPath, *example)
+	if err != nil {
+		return err
+	}
+	if name == utils.Stdin {
+		name = "<stdin>"
+	}
+	f, err := utils.OpenInput(*day, *inputPath, *example)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	start := time.Now()
+	input, err := puzzle.Parse(f)
+	if err != nil {
+		var diags parse.Diagnostics
+		if errors.As(err, &diags) {
+			diags.Format(os.Stderr, name)
+			return fmt.Errorf("day %d: %d parse errors in %s", *day, len(diags), name)
+		}
+		return fmt.Errorf("day %d: parse %s: %w", *day, name, err)
+	}
+	fmt.Printf("Parse: (%s)\n", time.Since(start).String())
+
+	for _, p := range parts {
+		variants := puzzle.Variants(p)
+		if *variant != "" {
+			variants = []string{*variant}
+		}
+		answers := map[string]string{}
+		for _, v := range variants {
+			label := "Part " + partName(p, v)
+			stop, err := prof.start(*day, aoc.Phase(p, v))
+			if err != nil {
+				return fmt.Errorf("starting profiles: %w", err)
+			}
+			start = time.Now()
+			ctx := logging.NewContext(context.Background(), dayLogger(logger, *day))
+			result, err := puzzle.SolveLimited(ctx, p, v, input, limits)
+			elapsed := time.Since(start)
+			if err := stop(); err != nil {
+				return fmt.Errorf("writing profiles: %w", err)
+			}
+			var limitErr *aoc.LimitError
+			if errors.As(err, &limitErr) {
+				os.Stderr.Write(limitErr.Goroutines)
+				fmt.Printf("%s: %v\n", label, limitErr)
+				return fmt.Errorf("day %d part %s: %w", *day, partName(p, v), err)
+			}
+			var panicErr *aoc.PanicError
+			if errors.As(err, &panicErr) {
+				os.Stderr.Write(panicErr.Stack)
+			}
+			if err != nil {
+				return fmt.Errorf("day %d part %s: %w", *day, partName(p, v), err)
+			}
+			fmt.Printf("%s: %v (%s)\n", label, result, elapsed.String())
+			answers[v] = aoc.Answer(result)
+		}
+		if err := agree(answers, variants); err != nil {
+			return fmt.Errorf("day %d part %d: %w", *day, p, err)
+		}
+	}
+	return nil
+}
+
+// agree checks that every variant of a part gave the same answer
+func agree(answers map[string]string, variants []string) error {
+	for _, v := range variants[1:] {
+		if answers[v] != answers[variants[0]] {
+			return fmt.Errorf("variants disagree: %s answered %s but %s answered %s",
+				variants[0], answers[variants[0]], v, answers[v])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+// partResult is the outcome of solving one variant of a part during `aoc run -all`
+type partResult struct {
+	aoc.Check
+	// Logs is everything the part logged, which is only shown if it failed
+	Logs logBuffer
+}
+
+// logBuffer is a buffer that's safe to write from a part that overran its limit and was
+// abandoned while it's still logging
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// dayInput is a day's input, parsed by whichever of its parts is picked up first
+type dayInput struct {
+	puzzle  *aoc.Puzzle
+	name    string
+	answers aoc.Answers
+	parse   func() (any, error)
+}
+
+// runAll solves every variant of every part of every registered day on a pool of workers.
+// Nothing is printed until all parts are done, and then the results are written as a table in
+// day and part order.
+func runAll(workers int, example bool, levels logging.Levels, limits aoc.Limits) error {
+	if limits.Memory > 0 {
+		return errors.New("-memlimit can't be used with -all as the days share a heap")
+	}
+	var results []*partResult
+	var inputs []*dayInput
+	for _, d := range aoc.Days() {
+		in, err := loadDay(d, example)
+		if errors.Is(err, os.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "skipping day %d: %v\n", d, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for p := 1; p <= 2; p++ {
+			for _, v := range in.puzzle.Variants(p) {
+				results = append(results, &partResult{Check: aoc.Check{
+					Day: d, Input: in.name, Part: p, Variant: v, Expected: in.answers[in.name].Part(p),
+				}})
+				inputs = append(inputs, in)
+			}
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Go(func() {
+			for i := range jobs {
+				solvePart(inputs[i], results[i], levels, limits)
+			}
+		})
+	}
+	for i := range results {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	checks := make([]aoc.Check, len(results))
+	for i, r := range results {
+		checks[i] = r.Check
+	}
+	aoc.CrossCheck(checks)
+	for i, r := range results {
+		r.Check = checks[i]
+		r.Grade()
+	}
+
+	return writeResults(os.Stdout, results)
+}
+
+func loadDay(day int, example bool) (*dayInput, error) {
+	puzzle, _ := aoc.Get(day)
+	dir, err := utils.DayDir(day)
+	if err != nil {
+		return nil, err
+	}
+	if example, err = exampleIfLocked(day, "", example); err != nil {
+		return nil, err
+	}
+	path, err := utils.InputPath(day, "", example)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := utils.InputExists(path); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("%s: %w", path, os.ErrNotExist)
+	}
+	answers, err := aoc.LoadAnswers(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &dayInput{
+		puzzle:  puzzle,
+		name:    filepath.Base(path),
+		answers: answers,
+		parse: sync.OnceValues(func() (any, error) {
+			b, err := utils.ReadInput(path)
+			if err != nil {
+				return nil, err
+			}
+			return puzzle.Parse(bytes.NewReader(b))
+		}),
+	}, nil
+}
+
+func solvePart(in *dayInput, r *partResult, levels logging.Levels, limits aoc.Limits) {
+	input, err := in.parse()
+	if err != nil {
+		r.Err = fmt.Errorf("parse %s: %w", in.name, err)
+		return
+	}
+
+	ctx := logging.NewContext(context.Background(), dayLogger(logging.New(&r.Logs, levels), r.Day))
+	start := time.Now()
+	result, err := in.puzzle.SolveLimited(ctx, r.Part, r.Variant, input, limits)
+	r.Elapsed = time.Since(start)
+	var limitErr *aoc.LimitError
+	if errors.As(err, &limitErr) {
+		r.Logs.Write(limitErr.Goroutines)
+	}
+	var panicErr *aoc.PanicError
+	if errors.As(err, &panicErr) {
+		r.Logs.Write(panicErr.Stack)
+	}
+	r.Actual, r.Err = aoc.Answer(result), err
+}
+
+func writeResults(w io.Writer, results []*partResult) error {
+	counts := map[aoc.Status]int{}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "day\tpart\tanswer\ttime\tcheck\t")
+	for _, r := range results {
+		counts[r.Status]++
+		answer := strings.ReplaceAll(r.Actual, "\n", `\n`)
+		if r.Err != nil {
+			answer = "error"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t\n", r.Day, partName(r.Part, r.Variant), answer, r.Elapsed, r.Status)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	// a day's parts all fail with the same parse errors, so they're shown in full once
+	formatted := map[string]bool{}
+	for _, r := range results {
+		if r.Status != aoc.Fail {
+			continue
+		}
+		fmt.Fprintf(w, "\nday %d part %s (%s):\n", r.Day, partName(r.Part, r.Variant), r.Input)
+		if r.Err != nil {
+			fmt.Fprintf(w, "    %v\n", r.Err)
+			var diags parse.Diagnostics
+			if name := fmt.Sprintf("day%d/%s", r.Day, r.Input); errors.As(r.Err, &diags) && !formatted[name] {
+				formatted[name] = true
+				var b strings.Builder
+				diags.Format(&b, name)
+				for line := range strings.Lines(b.String()) {
+					fmt.Fprint(w, "    ", line)
+				}
+			}
+		} else {
+			for line := range strings.Lines(aoc.Diff(r.Expected, r.Actual)) {
+				fmt.Fprint(w, "    ", line)
+			}
+		}
+		for line := range strings.Lines(r.Logs.String()) {
+			fmt.Fprint(w, "  | ", line)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d passed, %d failed, %d unknown\n", counts[aoc.Pass], counts[aoc.Fail], counts[aoc.Unknown])
+	if counts[aoc.Fail] > 0 {
+		return fmt.Errorf("%d parts failed", counts[aoc.Fail])
+	}
+	return nil
+}
+
+// partName is a part's number, followed by the variant's name if it isn't the Solver's own
+func partName(part int, variant string) string {
+	if variant == aoc.DefaultVariant {
+		return strconv.Itoa(part)
+	}
+	return fmt.Sprintf("%d/%s", part, variant)
+}
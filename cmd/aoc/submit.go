@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/client"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to submit")
+	part := fs.Int("part", 0, "part to submit (1 or 2)")
+	timeout := fs.Duration("timeout", time.Minute, "stop the part if it runs longer than this, 0 for no limit")
+	fs.Parse(args)
+	if *part != 1 && *part != 2 {
+		return errors.New("-part must be 1 or 2")
+	}
+
+	puzzle, ok := aoc.Get(*day)
+	if !ok {
+		return fmt.Errorf("day %d is not registered", *day)
+	}
+	dir, err := utils.DayDir(*day)
+	if err != nil {
+		return err
+	}
+	answers, err := aoc.LoadAnswers(dir)
+	if err != nil {
+		return err
+	}
+	root, err := utils.RepoRoot()
+	if err != nil {
+		return err
+	}
+	logPath := filepath.Join(root, client.AttemptsFile)
+	attempts, err := client.LoadAttempts(logPath)
+	if err != nil {
+		return err
+	}
+
+	answer, err := solveInput(puzzle, *part, aoc.Limits{Timeout: *timeout})
+	if err != nil {
+		return fmt.Errorf("day %d part %d: %w", *day, *part, err)
+	}
+	if answer == "" || strings.Contains(answer, "\n") {
+		return fmt.Errorf("day %d part %d: answer %q can't be submitted as is", *day, *part, answer)
+	}
+	fmt.Printf("Part %d: %s\n", *part, answer)
+
+	if expected := answers[utils.InputFile].Part(*part); expected != "" {
+		if expected == answer {
+			fmt.Println("already solved")
+			return nil
+		}
+		return fmt.Errorf("%w: %s records %s as the answer", client.ErrKnownWrong, aoc.AnswersFile, expected)
+	}
+	if err := client.CheckAttempt(attempts, *day, *part, answer, time.Now()); err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	result, err := c.Submit(context.Background(), *day, *part, answer)
+	if err != nil {
+		return err
+	}
+	attempt := client.Attempt{
+		Time: time.Now().UTC(), Day: *day, Part: *part, Answer: answer,
+		Verdict: result.Verdict, Hint: result.Hint, Wait: result.Wait,
+	}
+	if err := client.AppendAttempt(logPath, attempt); err != nil {
+		return fmt.Errorf("logging attempt: %w", err)
+	}
+	fmt.Println(result.Message)
+
+	switch result.Verdict {
+	case client.Correct:
+		e := answers[utils.InputFile]
+		e.Set(*part, answer)
+		answers[utils.InputFile] = e
+		return answers.Save(dir)
+	case client.WrongLevel:
+		return nil
+	}
+	return fmt.Errorf("day %d part %d: %s was %s", *day, *part, answer, result.Verdict)
+}
+
+// solveInput solves part against the day's real input, returning the answer as it's submitted
+func solveInput(puzzle *aoc.Puzzle, part int, limits aoc.Limits) (string, error) {
+	f, err := utils.OpenInput(puzzle.Day, "", false)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	input, err := puzzle.Parse(f)
+	if err != nil {
+		return "", err
+	}
+	result, err := puzzle.SolveLimited(context.Background(), part, aoc.DefaultVariant, input, limits)
+	if err != nil {
+		return "", err
+	}
+	return aoc.Answer(result), nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func verifyCmd(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to verify, all days if unset")
+	var logs logFlags
+	logs.register(fs)
+	fs.Parse(args)
+	logger, err := logs.logger(os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	days := aoc.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+
+	counts := map[aoc.Status]int{}
+	for _, d := range days {
+		puzzle, ok := aoc.Get(d)
+		if !ok {
+			return fmt.Errorf("day %d is not registered", d)
+		}
+		dir, err := utils.DayDir(d)
+		if err != nil {
+			return err
+		}
+		answers, err := aoc.LoadAnswers(dir)
+		if err != nil {
+			return err
+		}
+		inputs, err := aoc.Inputs(dir)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range inputs {
+			ctx := logging.NewContext(context.Background(), dayLogger(logger, d))
+			checks := puzzle.Verify(ctx, dir, name, answers)
+			// without the key only the examples can be checked
+			if len(checks) > 0 && errors.Is(checks[0].Err, utils.ErrNoInputKey) {
+				fmt.Fprintf(os.Stderr, "skipping day%d/%s: %v\n", d, name, checks[0].Err)
+				continue
+			}
+			for _, c := range checks {
+				counts[c.Status]++
+				printCheck(c)
+			}
+			// every part fails with the same parse errors, so they're shown in full once
+			var diags parse.Diagnostics
+			if len(checks) > 0 && errors.As(checks[0].Err, &diags) {
+				diags.Format(os.Stdout, fmt.Sprintf("day%d/%s", d, name))
+			}
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d unknown\n", counts[aoc.Pass], counts[aoc.Fail], counts[aoc.Unknown])
+	if counts[aoc.Fail] > 0 {
+		return fmt.Errorf("%d checks failed", counts[aoc.Fail])
+	}
+	return nil
+}
+
+func printCheck(c aoc.Check) {
+	fmt.Printf("%-7s day%d/%s part %s", c.Status, c.Day, c.Input, partName(c.Part, c.Variant))
+	switch {
+	case c.Err != nil:
+		fmt.Printf(": %v\n", c.Err)
+		var panicErr *aoc.PanicError
+		if errors.As(c.Err, &panicErr) {
+			for line := range strings.Lines(string(panicErr.Stack)) {
+				fmt.Print("    ", line)
+			}
+		}
+	case c.Status == aoc.Fail:
+		fmt.Println()
+		for line := range strings.Lines(aoc.Diff(c.Expected, c.Actual)) {
+			fmt.Print("    ", line)
+		}
+	default:
+		fmt.Printf(": %s (%s)\n", c.Actual, c.Elapsed)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func watchCmd(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to watch")
+	interval := fs.Duration("interval", 250*time.Millisecond, "how often to look for changes")
+	debounce := fs.Duration("debounce", 300*time.Millisecond, "how long files must be unchanged before rerunning")
+	fs.Parse(args)
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+
+	root, err := utils.RepoRoot()
+	if err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp("", "aoc-watch")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	w := &watcher{root: root, day: *day, bin: filepath.Join(tmp, "aoc")}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	files, err := w.snapshot()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("watching day%d, pkg and cmd/aoc (%d files)\n", *day, len(files))
+	w.start(ctx, "start")
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	var changed []string
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			w.stop()
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := w.snapshot()
+		if err != nil {
+			return err
+		}
+		if diff := changes(files, current); len(diff) > 0 {
+			// whatever's running is out of date, so stop it now rather than after the debounce
+			w.stop()
+			files = current
+			changed = append(changed, diff...)
+			lastChange = time.Now()
+			continue
+		}
+		if len(changed) > 0 && time.Since(lastChange) >= *debounce {
+			w.start(ctx, describeChanges(root, changed))
+			changed = nil
+		}
+	}
+}
+
+// watcher rebuilds the runner and verifies a day in the background, one run at a time
+type watcher struct {
+	root string
+	day  int
+	bin  string // where the runner is built to
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// start stops any run in progress and begins another
+func (w *watcher) start(ctx context.Context, reason string) {
+	w.stop()
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	go func() {
+		defer close(w.done)
+		w.run(ctx, reason)
+	}()
+}
+
+// stop cancels the run in progress, if there is one, and waits for it to finish
+func (w *watcher) stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+	w.cancel = nil
+}
+
+// run builds the runner from the current source, so edits to the day or pkg are picked up, and
+// verifies the day's examples and input against the expected answers
+func (w *watcher) run(ctx context.Context, reason string) {
+	fmt.Printf("\n=== %s %s\n", time.Now().Format(time.TimeOnly), reason)
+
+	start := time.Now()
+	build := w.command(ctx, "go", "build", "-o", w.bin, "./cmd/aoc")
+	if err := build.Run(); err != nil {
+		if ctx.Err() == nil {
+			fmt.Printf("=== build failed: %v\n", err)
+		}
+		return
+	}
+	built := time.Since(start)
+
+	start = time.Now()
+	err := w.command(ctx, w.bin, "verify", "-day", strconv.Itoa(w.day)).Run()
+	switch {
+	case ctx.Err() != nil:
+		fmt.Println("=== stopped")
+	case err != nil:
+		fmt.Printf("=== FAIL (build %s, run %s)\n", built.Round(time.Millisecond), time.Since(start).Round(time.Millisecond))
+	default:
+		fmt.Printf("=== ok (build %s, run %s)\n", built.Round(time.Millisecond), time.Since(start).Round(time.Millisecond))
+	}
+}
+
+func (w *watcher) command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = w.root
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.WaitDelay = time.Second
+	return cmd
+}
+
+// fileState is what's compared between polls to tell whether a file changed
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// snapshot records every file in the day's directory, which covers its code, inputs and
+// answers, and the Go files the runner is built from
+func (w *watcher) snapshot() (map[string]fileState, error) {
+	files := map[string]fileState{}
+	add := func(path string, info fs.FileInfo) {
+		files[path] = fileState{info.ModTime(), info.Size()}
+	}
+
+	entries, err := os.ReadDir(filepath.Join(w.root, fmt.Sprintf("day%d", w.day)))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if info, err := e.Info(); err == nil && !e.IsDir() {
+			add(filepath.Join(w.root, fmt.Sprintf("day%d", w.day), e.Name()), info)
+		}
+	}
+
+	for _, dir := range []string{"pkg", filepath.Join("cmd", "aoc")} {
+		err := filepath.WalkDir(filepath.Join(w.root, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			add(path, info)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range []string{"go.mod", "go.sum"} {
+		if info, err := os.Stat(filepath.Join(w.root, name)); err == nil {
+			add(filepath.Join(w.root, name), info)
+		}
+	}
+	return files, nil
+}
+
+// changes lists the files that were added, removed or modified between two snapshots
+func changes(before, after map[string]fileState) []string {
+	var changed []string
+	for path, state := range after {
+		if old, ok := before[path]; !ok || old != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+// describeChanges names the changed files relative to root, summarising if there are many
+func describeChanges(root string, changed []string) string {
+	set := map[string]bool{}
+	for _, path := range changed {
+		if rel, err := filepath.Rel(root, path); err == nil {
+			path = rel
+		}
+		set[path] = true
+	}
+	names := slices.Sorted(maps.Keys(set))
+	if len(names) > 3 {
+		return fmt.Sprintf("%s and %d more changed", strings.Join(names[:3], ", "), len(names)-3)
+	}
+	return strings.Join(names, ", ") + " changed"
+}
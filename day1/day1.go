@@ -0,0 +1,112 @@
+package day1
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func init() {
+	aoc.Register(1, Solver{})
+}
+
+type Solver struct{}
+
+// Parse returns each rotation as a signed number of clicks, negative for 'L'
+func (Solver) Parse(r io.Reader) ([]int, error) {
+	lr := utils.NewLineReader(r, utils.Trim, utils.DefaultMaxLine)
+	var diags parse.Diagnostics
+	var rotations []int
+	for i, line := range lr.Lines() {
+		n, err := parseLine(line)
+		if err != nil {
+			diags.Add(i, 0, line, err)
+		}
+		rotations = append(rotations, n)
+	}
+	if err := lr.Err(); err != nil {
+		return nil, err
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return rotations, nil
+}
+
+func (Solver) Part1(ctx context.Context, rotations []int) (any, error) {
+	result1, _ := spin(logging.FromContext(ctx), rotations)
+	return result1, nil
+}
+
+func (Solver) Part2(ctx context.Context, rotations []int) (any, error) {
+	_, result2 := spin(logging.FromContext(ctx), rotations)
+	return result2, nil
+}
+
+// spin turns the dial through every rotation, returning how many times it stopped on 0 (part 1)
+// and how many times it passed through 0 (part 2)
+func spin(logger *slog.Logger, rotations []int) (int, int) {
+	currValue := 50
+	result1, result2 := 0, 0
+
+	logger.Debug("starting", "value", currValue)
+	for _, n := range rotations {
+		currValue += n
+		logging.Trace(logger, "rotated", "clicks", n, "value", currValue)
+
+		// easier to capture here as capturing with result1 double accounts
+		if currValue == 0 {
+			logging.Trace(logger, "hit 0")
+			result2++
+		} else if x := currValue / 100; x != 0 {
+			// calculate if we went over +/-100 and if so how many times
+			if x < 0 {
+				x = x * -1
+			}
+
+			logging.Trace(logger, "passed 0", "times", x)
+			result2 += x
+		}
+
+		// if we've gone negative, excluding starting at 0, then add 1 for passing through 0.
+		if currValue < 0 && currValue != n {
+			logging.Trace(logger, "passed 0 going negative")
+			result2++
+		}
+
+		// convert calculated value to dial value
+		currValue = currValue % 100
+		if currValue < 0 {
+			currValue = 100 + currValue
+		}
+		if currValue == 0 {
+			result1++
+		}
+
+		logging.Trace(logger, "dial", "value", currValue)
+	}
+	return result1, result2
+}
+
+var rotationPattern = parse.MustCompile("{dir:R|L}{n:int}")
+
+func parseLine(line string) (int, error) {
+	var rotation struct {
+		Dir string
+		N   int
+	}
+	if err := rotationPattern.Scan(line, &rotation); err != nil {
+		return 0, err
+	}
+
+	if rotation.Dir == "L" {
+		return rotation.N * -1, nil
+	} else {
+		return rotation.N, nil
+	}
+}
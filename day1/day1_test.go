@@ -0,0 +1,11 @@
+package day1
+
+import (
+	"testing"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Examples(t, 1)
+}
@@ -0,0 +1,148 @@
+package day2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func init() {
+	aoc.Register(2, Solver{})
+	aoc.RegisterVariant(2, 1, "arithmetic", arithmeticPart1)
+}
+
+type Solver struct{}
+
+// IDRange is an inclusive range of product IDs
+type IDRange struct {
+	First int
+	Last  int
+}
+
+var idRangePattern = parse.MustCompile("{first:int}-{last:int}")
+
+func (Solver) Parse(r io.Reader) ([]IDRange, error) {
+	// the input is a single line that can be longer than bufio.Scanner's default limit
+	lr := utils.NewLineReader(r, utils.Trim, utils.LongLine)
+	var lines []string
+	for _, line := range lr.Lines() {
+		lines = append(lines, line)
+	}
+	if err := lr.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("empty input")
+	}
+
+	var diags parse.Diagnostics
+	var ranges []IDRange
+	offset := 0
+	for idRange := range strings.SplitSeq(lines[0], ",") {
+		var r IDRange
+		if err := idRangePattern.Scan(idRange, &r); err != nil {
+			// report against the whole line so the caret lands on the bad id range
+			diags.Add(1, offset+1, lines[0], err)
+		}
+		ranges = append(ranges, r)
+		offset += len(idRange) + 1
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+func (Solver) Part1(ctx context.Context, ranges []IDRange) (any, error) {
+	var result1 int
+	for _, r := range ranges {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i := r.First; i <= r.Last; i++ {
+			if s := strconv.Itoa(i); isInvalidP1(s) {
+				result1 += i
+			}
+		}
+	}
+	return result1, nil
+}
+
+func (Solver) Part2(ctx context.Context, ranges []IDRange) (any, error) {
+	logger := logging.FromContext(ctx)
+	var result2 int
+	for _, r := range ranges {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i := r.First; i <= r.Last; i++ {
+			if s := strconv.Itoa(i); isInvalidP2(s) {
+				logger.Debug("invalid id", "id", i)
+				result2 += i
+			}
+		}
+	}
+	return result2, nil
+}
+
+// arithmeticPart1 solves part 1 without checking every ID. An ID made of a k digit number x
+// repeated twice is x * (10^k + 1), so the invalid IDs in a range with k digit halves are an
+// arithmetic series that can be summed directly.
+func arithmeticPart1(ctx context.Context, ranges []IDRange) (any, error) {
+	var result1 int
+	for _, r := range ranges {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for pow := 10; pow/10*(pow+1) <= r.Last; pow *= 10 {
+			m := pow + 1
+			// the k digit halves x with First <= x*m <= Last
+			lo := max(pow/10, (r.First+m-1)/m)
+			hi := min(pow-1, r.Last/m)
+			if lo <= hi {
+				result1 += m * (lo + hi) * (hi - lo + 1) / 2
+			}
+		}
+	}
+	return result1, nil
+}
+
+func isInvalidP1(a string) bool {
+	if len(a)%2 != 0 {
+		return false
+	}
+	half := len(a) / 2
+	return a[0:half] == a[half:]
+}
+
+// e.g. let a be 789789. len(a) = 6
+func isInvalidP2(a string) bool {
+	for i := 2; i <= len(a); i++ {
+		if len(a)%i != 0 {
+			// not divisible by i, ignore
+			continue
+		}
+		// segment length, i is the number of segments
+		matching := true
+		sl := len(a) / i
+		for j := 0; j < i-1; j++ {
+			seg1 := a[sl*j : sl*(j+1)]
+			seg2 := a[sl*(j+1) : sl*(j+2)]
+			matching = matching && (seg1 == seg2)
+			if !matching {
+				break
+			}
+		}
+		if matching {
+			return true
+		}
+	}
+	return false
+}
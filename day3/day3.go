@@ -0,0 +1,148 @@
+package day3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func init() {
+	aoc.Register(3, Solver{})
+	aoc.RegisterVariant(3, 1, "greedy", greedy(2))
+	aoc.RegisterVariant(3, 2, "greedy", greedy(12))
+}
+
+type Solver struct{}
+
+// minBank is the fewest batteries a bank can have, as part 2 turns on 12 of them
+const minBank = 12
+
+// Parse returns each bank of batteries as a line of digits, skipping blank lines
+func (Solver) Parse(r io.Reader) ([]string, error) {
+	lr := utils.NewLineReader(r, utils.Trim, utils.DefaultMaxLine)
+	var diags parse.Diagnostics
+	var lines []string
+	for i, line := range lr.Bytes() {
+		if len(line) == 0 {
+			continue
+		}
+		if len(line) < minBank {
+			diags.Add(i, 0, string(line), fmt.Errorf("bank has %d batteries, expected at least %d", len(line), minBank))
+			continue
+		}
+		if j := bytes.IndexFunc(line, func(r rune) bool { return r < '0' || r > '9' }); j >= 0 {
+			diags.Add(i, j+1, string(line), errors.New("battery joltage must be a digit"))
+		}
+		lines = append(lines, string(line))
+	}
+	if err := lr.Err(); err != nil {
+		return nil, err
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func (Solver) Part1(ctx context.Context, lines []string) (any, error) {
+	logger := logging.FromContext(ctx)
+	var result1 int
+	for _, line := range lines {
+		result1 += part1(logger, line)
+	}
+	return result1, nil
+}
+
+func (Solver) Part2(ctx context.Context, lines []string) (any, error) {
+	logger := logging.FromContext(ctx)
+	var result2 uint64
+	for _, line := range lines {
+		result2 += part2(logger, line)
+	}
+	return result2, nil
+}
+
+func part1(logger *slog.Logger, line string) int {
+	max10s, maxIndex := 0, 0
+	// search up until the penultimate rune for the largest number
+	for j := range len(line) - 1 {
+		x := int(line[j] - 48)
+		// want strictly greater than as if there's a repeated max character, want to use that for units rather than 10s
+		if x > max10s {
+			max10s = x
+			maxIndex = j
+		}
+	}
+
+	maxUnits := 0
+	for j := maxIndex + 1; j < len(line); j++ {
+		x := int(line[j] - 48)
+		if x > maxUnits {
+			maxUnits = x
+		}
+	}
+
+	thisJoltage := (max10s * 10) + maxUnits
+	logger.Debug("bank", "line", line, "joltage", thisJoltage, "tens", maxIndex)
+	return thisJoltage
+}
+
+func part2(logger *slog.Logger, line string) uint64 {
+	nums := make([]int, len(line))
+	for j := range line {
+		nums[j] = int(line[j] - 48)
+	}
+
+	var joltage [12]int
+	var indices [13]int
+	for k := range 12 {
+		for j := indices[k]; j < len(nums)-(11-k); j++ {
+			if nums[j] > joltage[k] {
+				joltage[k] = nums[j]
+				// set the starting index for the next iteration
+				indices[k+1] = j + 1
+			}
+		}
+	}
+
+	logger.Debug("bank", "line", line, "joltage", joltage, "indices", indices)
+
+	var result float64
+	for k, v := range joltage {
+		result += float64(v) * math.Pow(10, float64(11-k))
+		logging.Trace(logger, "sum", "digit", k, "result", result)
+	}
+	return uint64(result)
+}
+
+// greedy solves either part for banks of the given number of batteries, choosing each digit as
+// the largest that still leaves enough batteries after it for the rest
+func greedy(batteries int) func(context.Context, []string) (any, error) {
+	return func(ctx context.Context, lines []string) (any, error) {
+		var result int
+		for _, line := range lines {
+			var joltage, start int
+			for k := range batteries {
+				best := start
+				for j := start; j < len(line)-(batteries-1-k); j++ {
+					if line[j] > line[best] {
+						best = j
+					}
+				}
+				joltage = joltage*10 + int(line[best]-'0')
+				start = best + 1
+			}
+			result += joltage
+		}
+		return result, nil
+	}
+}
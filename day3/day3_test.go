@@ -0,0 +1,23 @@
+package day3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc/aoctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Examples(t, 3)
+}
+
+func TestParse(t *testing.T) {
+	lines, err := Solver{}.Parse(strings.NewReader("987654321111111\n\n"))
+	require.NoError(t, err, "a trailing blank line is skipped")
+	require.Equal(t, []string{"987654321111111"}, lines)
+
+	// every variant would otherwise have to cope with banks too short to choose from
+	_, err = Solver{}.Parse(strings.NewReader("987654321111111\n9\n"))
+	require.ErrorContains(t, err, "bank has 1 batteries, expected at least 12")
+}
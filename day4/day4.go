@@ -0,0 +1,151 @@
+package day4
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/slowgraph"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func init() {
+	aoc.Register(4, Solver{})
+	aoc.RegisterVariant(4, 1, "naive", naivePart1)
+}
+
+type Solver struct{}
+
+// Parse returns the grid of paper rolls ('@') and empty tiles ('.')
+func (Solver) Parse(r io.Reader) (slowgraph.GridGraph, error) {
+	lines, err := utils.ReadLines(r)
+	if err != nil {
+		return slowgraph.GridGraph{}, err
+	}
+	if len(lines) == 0 {
+		return slowgraph.GridGraph{}, errors.New("empty input")
+	}
+	return slowgraph.NewGraph(&slowgraph.Chess{}, lines,
+		func(slowgraph.Coord, slowgraph.Coord) uint { return 1 }), nil
+}
+
+func (Solver) Part1(ctx context.Context, graph slowgraph.GridGraph) (any, error) {
+	logger := logging.FromContext(ctx)
+	var result1 int
+	err := graph.FloodFillContext(ctx, slowgraph.Coord{X: 0, Y: 0}, func(current slowgraph.Coord, neighbours []slowgraph.Coord) {
+		var paper int
+		d := graph.GetCoordData(current)
+		if d == '@' {
+			for _, c := range neighbours {
+				if graph.GetCoordData(c) == '@' {
+					paper++
+				}
+			}
+			if paper < 4 {
+				result1++
+				logging.Trace(logger, "accessible", "x", current.X, "y", current.Y)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result1, nil
+}
+
+// Part2 works on its own copy of the grid data as we need to start changing the graph inline
+func (Solver) Part2(ctx context.Context, graph slowgraph.GridGraph) (any, error) {
+	removed := true
+	var result2 int
+
+	for removed {
+		removed = false
+		newGraph := make([]rune, len(graph.Data))
+		copy(newGraph, graph.Data)
+		err := graph.FloodFillContext(ctx, slowgraph.Coord{X: 0, Y: 0}, func(current slowgraph.Coord, neighbours []slowgraph.Coord) {
+			var paper int
+			d := graph.GetCoordData(current)
+			if d == '@' {
+				// Count surrounding paper
+				for _, c := range neighbours {
+					if graph.GetCoordData(c) == '@' {
+						paper++
+					}
+				}
+				if paper < 4 {
+					// Paper is accessible, so remove it
+					removed = true
+					newGraph[current.Y*graph.NumCols+current.X] = '.'
+					result2++
+				}
+			}
+
+		})
+		if err != nil {
+			return nil, err
+		}
+		graph.Data = newGraph
+	}
+
+	return result2, nil
+}
+
+// naivePart1 was the first approach to part 1, checking every tile's neighbours in place rather
+// than flood filling the graph
+func naivePart1(ctx context.Context, graph slowgraph.GridGraph) (any, error) {
+	logger := logging.FromContext(ctx)
+	var result1 int
+
+	for row := range int(graph.NumRows) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// the row with accessible paper marked with an x
+		var marked strings.Builder
+		for col := range int(graph.NumCols) {
+			char := graph.GetCoordData(slowgraph.Coord{X: uint(col), Y: uint(row)})
+			if char == '@' {
+				if lookAround(graph, row, col) < 4 {
+					result1++
+					marked.WriteByte('x')
+					continue
+				}
+			}
+			marked.WriteRune(char)
+		}
+		logging.Trace(logger, "row", "y", row, "marked", marked.String())
+	}
+	return result1, nil
+}
+
+func lookAround(graph slowgraph.GridGraph, row int, col int) int {
+	var result int
+	for i := -1; i <= 1; i++ {
+		// out of bounds check
+		checkRow := row + i
+		if checkRow < 0 || checkRow >= int(graph.NumRows) {
+			continue
+		}
+
+		for j := -1; j <= 1; j++ {
+			if i == 0 && j == 0 {
+				// don't check current value
+				continue
+			}
+
+			// out of bounds check
+			checkCol := col + j
+			if checkCol < 0 || checkCol >= int(graph.NumCols) {
+				continue
+			}
+
+			if graph.GetCoordData(slowgraph.Coord{X: uint(checkCol), Y: uint(checkRow)}) == '@' {
+				result++
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package day5
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+	"github.com/josiemessa/aoc2025/pkg/logging"
+	"github.com/josiemessa/aoc2025/pkg/parse"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+func init() {
+	aoc.Register(5, Solver{})
+}
+
+type rangeList struct {
+	starts []uint64
+	ends   []uint64
+}
+
+func (r *rangeList) Len() int {
+	return len(r.starts)
+}
+
+func (r *rangeList) Less(i, j int) bool {
+	if r.starts[i] == r.starts[j] {
+		return r.ends[i] < r.ends[j]
+	}
+	return r.starts[i] < r.starts[j]
+}
+
+func (r *rangeList) Swap(i, j int) {
+	oldStarts := r.starts[i]
+	r.starts[i] = r.starts[j]
+	r.starts[j] = oldStarts
+
+	oldEnds := r.ends[i]
+	r.ends[i] = r.ends[j]
+	r.ends[j] = oldEnds
+}
+
+func (r *rangeList) In(ingredient uint64, j int) (int, bool) {
+	if j >= r.Len() {
+		return -1, false
+	}
+	if ingredient == r.starts[j] {
+		return j, true
+	}
+	if ingredient == r.ends[j] {
+		return j, true
+	}
+	if ingredient > r.starts[j] {
+		if ingredient < r.ends[j] {
+			// inside this range, we found it!
+			return j, true
+		}
+	}
+	return -1, false
+}
+
+type Solver struct{}
+
+// Input is the database of fresh ingredient ID ranges, sorted by range start, and the available
+// ingredient IDs
+type Input struct {
+	fresh       *rangeList
+	ingredients []uint64
+}
+
+func (Solver) Parse(r io.Reader) (Input, error) {
+	sections, err := parse.ReadSections(r, utils.Trim)
+	if err != nil {
+		return Input{}, err
+	}
+	if err := parse.ExpectSections(sections, 2); err != nil {
+		return Input{}, err
+	}
+
+	var diags parse.Diagnostics
+	ranges, err := sections[0].Ranges()
+	if err := diags.Merge(err); err != nil {
+		return Input{}, err
+	}
+	fresh := &rangeList{
+		starts: make([]uint64, len(ranges)),
+		ends:   make([]uint64, len(ranges)),
+	}
+	for i, r := range ranges {
+		fresh.starts[i] = r.Lo
+		fresh.ends[i] = r.Hi
+	}
+
+	// sort by smallest range start
+	sort.Sort(fresh)
+
+	ingredients, err := sections[1].Uints()
+	if err := diags.Merge(err); err != nil {
+		return Input{}, err
+	}
+	if err := diags.Err(); err != nil {
+		return Input{}, err
+	}
+
+	return Input{fresh: fresh, ingredients: ingredients}, nil
+}
+
+func (Solver) Part1(ctx context.Context, input Input) (any, error) {
+	logger := logging.FromContext(ctx)
+	fresh := input.fresh
+	var result1 int
+	for _, ingredient := range input.ingredients {
+		var found bool
+		var idx int
+		for j := 0; j < fresh.Len(); j++ {
+			idx, found = fresh.In(ingredient, j)
+			if found {
+				break
+			}
+		}
+
+		if found {
+			result1++
+			logging.Trace(logger, "fresh", "ingredient", ingredient, "start", fresh.starts[idx], "end", fresh.ends[idx])
+			continue
+		}
+	}
+
+	return result1, nil
+}
+
+func (Solver) Part2(ctx context.Context, input Input) (any, error) {
+	fresh := input.fresh
+	var result2 uint64
+
+	for i := 0; i < fresh.Len(); i++ {
+		start := fresh.starts[i]
+		end := fresh.ends[i]
+		// merge in any of the next ranges that overlap with this one, comparing against the end of
+		// the merged range so far. We can stop at the first one that doesn't as they are ordered
+		for i+1 < fresh.Len() && fresh.starts[i+1] <= end {
+			i++
+			if fresh.ends[i] > end {
+				end = fresh.ends[i]
+			}
+		}
+
+		result2 += end - start + 1
+	}
+
+	return result2, nil
+}
@@ -0,0 +1,83 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// AnswersFile is the name of the file in each day's directory holding its known answers
+const AnswersFile = "answers.json"
+
+// Expected holds the known answer to each part for one input, empty if it isn't known yet
+type Expected struct {
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+}
+
+// Part returns the expected answer for part (1 or 2)
+func (e Expected) Part(part int) string {
+	switch part {
+	case 1:
+		return e.Part1
+	case 2:
+		return e.Part2
+	}
+	return ""
+}
+
+// Set records the expected answer for part (1 or 2)
+func (e *Expected) Set(part int, answer string) {
+	switch part {
+	case 1:
+		e.Part1 = answer
+	case 2:
+		e.Part2 = answer
+	}
+}
+
+// Answers are a day's expected answers keyed by input file name, e.g. "input" or "test-input"
+type Answers map[string]Expected
+
+// LoadAnswers reads the answers file in a day's directory. A missing file means nothing is
+// known yet, so it returns empty Answers rather than an error.
+func LoadAnswers(dir string) (Answers, error) {
+	b, err := os.ReadFile(filepath.Join(dir, AnswersFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Answers{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	answers := Answers{}
+	if err := json.Unmarshal(b, &answers); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Join(dir, AnswersFile), err)
+	}
+	return answers, nil
+}
+
+// Save writes the answers file in a day's directory
+func (a Answers) Save(dir string) error {
+	b, err := a.Marshal()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, AnswersFile), b, 0o644)
+}
+
+// Marshal returns the contents of the answers file for a
+func (a Answers) Marshal() ([]byte, error) {
+	b, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// Answer formats a part's result the way it's stored in the answers file and submitted
+func Answer(result any) string {
+	return fmt.Sprint(result)
+}
@@ -0,0 +1,158 @@
+// Package aoc is the registry of puzzle solvers. Each day registers itself from init()
+// so that a single runner can parse and solve any day.
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"slices"
+)
+
+// Solver solves a single day's puzzle. Parse is called once per input and the parsed value is
+// handed to both parts, so parts must not modify it. Parts should return ctx.Err() promptly once
+// ctx is done, which is how the runner stops a part that has run out of time or memory.
+type Solver[T any] interface {
+	Parse(r io.Reader) (T, error)
+	Part1(ctx context.Context, input T) (any, error)
+	Part2(ctx context.Context, input T) (any, error)
+}
+
+// DefaultVariant is the name of each part's implementation given by the day's Solver
+const DefaultVariant = "default"
+
+// Puzzle is a registered Solver with its input type erased, so the runner can drive any day
+type Puzzle struct {
+	Day int
+
+	parse func(io.Reader) (any, error)
+	// parts holds each part's implementations, the Solver's own first
+	parts [2][]variant
+	// inputType is a nil *T for the Solver's input type T, so variants can be checked against it
+	inputType any
+}
+
+type variant struct {
+	name  string
+	solve func(context.Context, any) (any, error)
+}
+
+var registry = map[int]*Puzzle{}
+
+// Register adds s as the solver for day. It panics if the day is already registered.
+func Register[T any](day int, s Solver[T]) {
+	if _, ok := registry[day]; ok {
+		panic(fmt.Sprintf("aoc: day %d registered twice", day))
+	}
+	registry[day] = &Puzzle{
+		Day:   day,
+		parse: func(r io.Reader) (any, error) { return s.Parse(r) },
+		parts: [2][]variant{
+			{{DefaultVariant, func(ctx context.Context, input any) (any, error) { return s.Part1(ctx, input.(T)) }}},
+			{{DefaultVariant, func(ctx context.Context, input any) (any, error) { return s.Part2(ctx, input.(T)) }}},
+		},
+		inputType: (*T)(nil),
+	}
+}
+
+// RegisterVariant adds an alternative implementation of part of a registered day, e.g. a naive
+// version kept alongside a faster one. Every variant is run, cross-checked and benchmarked with
+// the Solver's. It panics if the day isn't registered, takes a different input type, or already
+// has a variant of the part with that name.
+func RegisterVariant[T any](day, part int, name string, solve func(ctx context.Context, input T) (any, error)) {
+	p, ok := registry[day]
+	if !ok {
+		panic(fmt.Sprintf("aoc: variant %s of day %d registered before the day", name, day))
+	}
+	if _, ok := p.inputType.(*T); !ok {
+		panic(fmt.Sprintf("aoc: variant %s of day %d takes %T, not the solver's input", name, day, *new(T)))
+	}
+	if part < 1 || part > len(p.parts) {
+		panic(fmt.Sprintf("aoc: variant %s of day %d is for part %d", name, day, part))
+	}
+	if slices.Contains(p.Variants(part), name) {
+		panic(fmt.Sprintf("aoc: variant %s of day %d part %d registered twice", name, day, part))
+	}
+	p.parts[part-1] = append(p.parts[part-1], variant{name, func(ctx context.Context, input any) (any, error) {
+		return solve(ctx, input.(T))
+	}})
+}
+
+// Get returns the puzzle registered for day
+func Get(day int) (*Puzzle, bool) {
+	p, ok := registry[day]
+	return p, ok
+}
+
+// Days returns every registered day in ascending order
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for d := range registry {
+		days = append(days, d)
+	}
+	slices.Sort(days)
+	return days
+}
+
+// Parse reads the puzzle input from r
+func (p *Puzzle) Parse(r io.Reader) (any, error) {
+	return p.parse(r)
+}
+
+// Variants returns the names of part's implementations, starting with DefaultVariant
+func (p *Puzzle) Variants(part int) []string {
+	if part < 1 || part > len(p.parts) {
+		return nil
+	}
+	var names []string
+	for _, v := range p.parts[part-1] {
+		names = append(names, v.name)
+	}
+	return names
+}
+
+// Solve runs the Solver's implementation of part (1 or 2) against input, which must have come
+// from Parse
+func (p *Puzzle) Solve(ctx context.Context, part int, input any) (any, error) {
+	return p.SolveVariant(ctx, part, DefaultVariant, input)
+}
+
+// SolveVariant runs the named implementation of part against input. A panic in the part is
+// returned as a PanicError, so it fails that part rather than everything running alongside it.
+func (p *Puzzle) SolveVariant(ctx context.Context, part int, name string, input any) (answer any, err error) {
+	if part < 1 || part > len(p.parts) {
+		return nil, fmt.Errorf("day %d has no part %d", p.Day, part)
+	}
+	for _, v := range p.parts[part-1] {
+		if v.name == name {
+			defer func() {
+				if r := recover(); r != nil {
+					answer, err = nil, &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+			return v.solve(ctx, input)
+		}
+	}
+	return nil, fmt.Errorf("day %d part %d has no variant %q", p.Day, part, name)
+}
+
+// PanicError is returned when a part panics
+type PanicError struct {
+	Value any
+	// Stack is the panicking goroutine's stack, showing where the part went wrong
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Phase names a part's implementation in reports: part1 for the Solver's own, part1/naive for
+// a variant
+func Phase(part int, variant string) string {
+	if variant == DefaultVariant || variant == "" {
+		return fmt.Sprintf("part%d", part)
+	}
+	return fmt.Sprintf("part%d/%s", part, variant)
+}
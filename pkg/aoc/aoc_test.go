@@ -0,0 +1,66 @@
+package aoc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSolver struct{}
+
+func (testSolver) Parse(r io.Reader) (int, error) { return 21, nil }
+
+func (testSolver) Part1(ctx context.Context, n int) (any, error) { return n * 2, nil }
+
+func (testSolver) Part2(ctx context.Context, n int) (any, error) { return n, nil }
+
+func TestRegisterVariant(t *testing.T) {
+	const day = 1000
+	Register(day, testSolver{})
+	RegisterVariant(day, 1, "shift", func(ctx context.Context, n int) (any, error) { return n << 1, nil })
+	t.Cleanup(func() { delete(registry, day) })
+
+	p, ok := Get(day)
+	require.True(t, ok)
+	require.Equal(t, []string{DefaultVariant, "shift"}, p.Variants(1))
+	require.Equal(t, []string{DefaultVariant}, p.Variants(2))
+
+	answer, err := p.SolveVariant(t.Context(), 1, "shift", 21)
+	require.NoError(t, err)
+	require.Equal(t, 42, answer)
+	_, err = p.SolveVariant(t.Context(), 2, "shift", 21)
+	require.ErrorContains(t, err, `no variant "shift"`)
+
+	require.Panics(t, func() {
+		RegisterVariant(day, 1, "shift", func(ctx context.Context, n int) (any, error) { return n, nil })
+	})
+	require.Panics(t, func() {
+		RegisterVariant(day, 2, "text", func(ctx context.Context, s string) (any, error) { return s, nil })
+	})
+}
+
+func TestPhase(t *testing.T) {
+	require.Equal(t, "part1", Phase(1, DefaultVariant))
+	require.Equal(t, "part2/naive", Phase(2, "naive"))
+}
+
+func TestSolveVariantPanic(t *testing.T) {
+	const day = 1001
+	Register(day, testSolver{})
+	RegisterVariant(day, 1, "index", func(ctx context.Context, n int) (any, error) { return []int{}[n], nil })
+	t.Cleanup(func() { delete(registry, day) })
+	p, _ := Get(day)
+
+	_, err := p.SolveVariant(t.Context(), 1, "index", 21)
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	require.ErrorContains(t, err, "index out of range [21] with length 0")
+	require.Contains(t, string(panicErr.Stack), "aoc.TestSolveVariantPanic")
+
+	// the other parts are unaffected
+	answer, err := p.SolveVariant(t.Context(), 1, DefaultVariant, 21)
+	require.NoError(t, err)
+	require.Equal(t, 42, answer)
+}
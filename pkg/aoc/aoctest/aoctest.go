@@ -0,0 +1,67 @@
+// Package aoctest runs a day's example inputs as go tests, so adding an example only means
+// dropping a test-input file and its answers into the day's directory.
+package aoctest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/josiemessa/aoc2025/pkg/aoc"
+)
+
+// Examples runs every test-input* file in the current directory (a day's package directory
+// under go test) through every variant of the registered solver for day, as subtests named
+// like day5/example1/part2 or day4/example1/part1/naive. Parts without an expected answer in
+// answers.json are skipped, and empty example files such as those created by `aoc new` are left
+// out by aoc.Inputs.
+func Examples(t *testing.T, day int) {
+	t.Helper()
+	puzzle, ok := aoc.Get(day)
+	if !ok {
+		t.Fatalf("day %d is not registered", day)
+	}
+
+	answers, err := aoc.LoadAnswers(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs, err := aoc.Inputs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run(fmt.Sprintf("day%d", day), func(t *testing.T) {
+		var n int
+		for _, name := range inputs {
+			num := aoc.ExampleNumber(name)
+			if num == 0 {
+				continue
+			}
+			n++
+			// named by the file's own number, so -run picks the file whatever other examples exist
+			t.Run(fmt.Sprintf("example%d", num), func(t *testing.T) {
+				checks := puzzle.Verify(t.Context(), ".", name, answers)
+				for _, c := range checks {
+					t.Run(aoc.Phase(c.Part, c.Variant), func(t *testing.T) {
+						switch {
+						case c.Err != nil:
+							var panicErr *aoc.PanicError
+							if errors.As(c.Err, &panicErr) {
+								t.Fatalf("%s: %v\n%s", c.Input, c.Err, panicErr.Stack)
+							}
+							t.Fatalf("%s: %v", c.Input, c.Err)
+						case c.Status == aoc.Unknown:
+							t.Skipf("%s: no expected answer for part %d in %s (got %s)", c.Input, c.Part, aoc.AnswersFile, c.Actual)
+						case c.Status == aoc.Fail:
+							t.Errorf("%s:\n%s", c.Input, aoc.Diff(c.Expected, c.Actual))
+						}
+					})
+				}
+			})
+		}
+		if n == 0 {
+			t.Skip("no test-input files")
+		}
+	})
+}
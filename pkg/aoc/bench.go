@@ -0,0 +1,146 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Timing summarises repeated runs of one phase of a solution: parse, part1 or part2, or a
+// variant such as part1/naive
+type Timing struct {
+	Phase  string        `json:"phase"`
+	Runs   int           `json:"runs"`
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+	// Allocs and Bytes are the average heap allocations per run
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+	// Samples is the wall time of every run, kept so runs can be compared statistically
+	Samples []time.Duration `json:"samples_ns,omitempty"`
+}
+
+// BenchResult is every timed phase for one day's input
+type BenchResult struct {
+	Day     int      `json:"day"`
+	Input   string   `json:"input"`
+	Timings []Timing `json:"timings"`
+}
+
+// Bench parses input and solves every variant of each of parts runs times, timing every phase
+// separately. The input is held in memory so reading it isn't counted.
+func (p *Puzzle) Bench(input []byte, runs int, parts []int) ([]Timing, error) {
+	var parsed any
+	parse, err := measure("parse", runs, func() error {
+		var err error
+		parsed, err = p.Parse(bytes.NewReader(input))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+
+	timings := []Timing{parse}
+	for _, part := range parts {
+		for _, v := range p.Variants(part) {
+			phase := Phase(part, v)
+			t, err := measure(phase, runs, func() error {
+				_, err := p.SolveVariant(context.Background(), part, v, parsed)
+				return err
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", phase, err)
+			}
+			timings = append(timings, t)
+		}
+	}
+	return timings, nil
+}
+
+// measure runs f runs times, recording the wall time and allocations of each run
+func measure(phase string, runs int, f func() error) (Timing, error) {
+	runs = max(runs, 1)
+	durations := make([]time.Duration, runs)
+	var before, after runtime.MemStats
+	var allocs, bytes uint64
+
+	for i := range durations {
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		if err := f(); err != nil {
+			return Timing{}, err
+		}
+		durations[i] = time.Since(start)
+		runtime.ReadMemStats(&after)
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+	}
+
+	slices.Sort(durations)
+	return Timing{
+		Phase:   phase,
+		Runs:    runs,
+		Min:     durations[0],
+		Median:  median(durations),
+		P95:     durations[int(math.Ceil(0.95*float64(runs)))-1],
+		Allocs:  allocs / uint64(runs),
+		Bytes:   bytes / uint64(runs),
+		Samples: durations,
+	}, nil
+}
+
+// median of sorted durations
+func median(d []time.Duration) time.Duration {
+	if len(d)%2 == 1 {
+		return d[len(d)/2]
+	}
+	return (d[len(d)/2-1] + d[len(d)/2]) / 2
+}
+
+// Report formats for WriteReport
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+)
+
+// WriteReport writes a summary table of results in format (text, markdown or json)
+func WriteReport(w io.Writer, results []BenchResult, format string) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	case FormatMarkdown:
+		fmt.Fprintln(w, "| Day | Input | Phase | Runs | Min | Median | p95 | Allocs | Bytes |")
+		fmt.Fprintln(w, "|----:|-------|-------|-----:|----:|-------:|----:|-------:|------:|")
+		for _, r := range results {
+			for _, t := range r.Timings {
+				fmt.Fprintf(w, "| %d | %s | %s | %d | %s | %s | %s | %d | %d |\n",
+					r.Day, r.Input, t.Phase, t.Runs, t.Min, t.Median, t.P95, t.Allocs, t.Bytes)
+			}
+		}
+		return nil
+	case FormatText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, "day\tinput\tphase\truns\tmin\tmedian\tp95\tallocs\tbytes\t")
+		for _, r := range results {
+			for _, t := range r.Timings {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\t%s\t%d\t%d\t\n",
+					r.Day, r.Input, t.Phase, t.Runs, t.Min, t.Median, t.P95, t.Allocs, t.Bytes)
+			}
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown report format %q, want one of %s", format,
+		strings.Join([]string{FormatText, FormatMarkdown, FormatJSON}, ", "))
+}
@@ -0,0 +1,50 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasure(t *testing.T) {
+	var calls int
+	timing, err := measure("part1", 20, func() error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 20, calls)
+	require.Equal(t, "part1", timing.Phase)
+	require.LessOrEqual(t, timing.Min, timing.Median)
+	require.LessOrEqual(t, timing.Median, timing.P95)
+
+	_, err = measure("parse", 5, func() error { return errors.New("bad input") })
+	require.ErrorContains(t, err, "bad input")
+}
+
+func TestMedian(t *testing.T) {
+	require.Equal(t, 2*time.Second, median([]time.Duration{time.Second, 2 * time.Second, 5 * time.Second}))
+	require.Equal(t, 3*time.Second, median([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}))
+}
+
+func TestWriteReport(t *testing.T) {
+	results := []BenchResult{{Day: 4, Input: "input", Timings: []Timing{
+		{Phase: "parse", Runs: 3, Min: time.Millisecond, Median: 2 * time.Millisecond, P95: 3 * time.Millisecond, Allocs: 7, Bytes: 512},
+	}}}
+
+	var md strings.Builder
+	require.NoError(t, WriteReport(&md, results, FormatMarkdown))
+	require.Contains(t, md.String(), "| 4 | input | parse | 3 | 1ms | 2ms | 3ms | 7 | 512 |")
+
+	var js strings.Builder
+	require.NoError(t, WriteReport(&js, results, FormatJSON))
+	var decoded []BenchResult
+	require.NoError(t, json.Unmarshal([]byte(js.String()), &decoded))
+	require.Equal(t, results, decoded)
+
+	require.Error(t, WriteReport(&js, results, "csv"))
+}
@@ -0,0 +1,220 @@
+package aoc
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// HistoryFile is where bench results are kept, relative to the repo root. It's local to each
+// clone as timings depend on the machine.
+const HistoryFile = ".aoc/bench-history.jsonl"
+
+// HistoryRecord is one benchmarked phase from one `aoc bench` run
+type HistoryRecord struct {
+	Time   time.Time `json:"time"`
+	Commit string    `json:"commit"`
+	// Dirty is set if the working tree had uncommitted changes, so the timings may not be
+	// of Commit exactly
+	Dirty bool   `json:"dirty,omitempty"`
+	Day   int    `json:"day"`
+	Input string `json:"input"`
+	Timing
+}
+
+// AppendHistory adds records to the end of the history file at path, one JSON object per line
+func AppendHistory(path string, records []HistoryRecord) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+// LoadHistory reads every record in the history file at path. A missing file is an empty history.
+func LoadHistory(path string) ([]HistoryRecord, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []HistoryRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<24)
+	for n := 1; scanner.Scan(); n++ {
+		var r HistoryRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
+
+// Comparison is how one phase's timings changed between two commits
+type Comparison struct {
+	Day    int
+	Input  string
+	Phase  string
+	A, B   []time.Duration // every sample recorded at each commit
+	Delta  float64         // change in median from A to B, as a fraction of A's median
+	P      float64         // p-value of the Mann-Whitney U test that A and B differ
+	Slower bool            // B is significantly slower than A by more than the threshold
+}
+
+// Alpha is the significance level below which a difference in timings is reported
+const Alpha = 0.05
+
+// Compare matches the phases benchmarked at commits a and b, pooling the samples from every run
+// at each commit. Dirty records are left out, as they timed code that isn't in either commit. A
+// phase is flagged Slower if its median grew by more than threshold (e.g. 0.1 for 10%) and the
+// difference is significant.
+func Compare(history []HistoryRecord, a, b string, threshold float64) []Comparison {
+	type key struct {
+		day          int
+		input, phase string
+	}
+	samples := map[key]*Comparison{}
+	var keys []key
+	for _, r := range history {
+		if r.Dirty || r.Commit != a && r.Commit != b {
+			continue
+		}
+		k := key{r.Day, r.Input, r.Phase}
+		c, ok := samples[k]
+		if !ok {
+			c = &Comparison{Day: r.Day, Input: r.Input, Phase: r.Phase}
+			samples[k] = c
+			keys = append(keys, k)
+		}
+		if r.Commit == a {
+			c.A = append(c.A, r.Samples...)
+		}
+		if r.Commit == b {
+			c.B = append(c.B, r.Samples...)
+		}
+	}
+
+	slices.SortFunc(keys, func(x, y key) int {
+		if x.day != y.day {
+			return x.day - y.day
+		}
+		if x.input != y.input {
+			return strings.Compare(x.input, y.input)
+		}
+		return strings.Compare(x.phase, y.phase)
+	})
+
+	var result []Comparison
+	for _, k := range keys {
+		c := samples[k]
+		if len(c.A) == 0 || len(c.B) == 0 {
+			continue
+		}
+		slices.Sort(c.A)
+		slices.Sort(c.B)
+		ma, mb := median(c.A), median(c.B)
+		if ma > 0 {
+			c.Delta = float64(mb-ma) / float64(ma)
+		}
+		c.P = mannWhitneyU(c.A, c.B)
+		c.Slower = c.P < Alpha && c.Delta > threshold
+		result = append(result, *c)
+	}
+	return result
+}
+
+// mannWhitneyU returns the two-sided p-value that a and b come from different distributions,
+// using the normal approximation with a correction for ties. It's the test benchstat uses, so it
+// doesn't assume the timings are normally distributed.
+func mannWhitneyU(a, b []time.Duration) float64 {
+	type sample struct {
+		d     time.Duration
+		fromA bool
+	}
+	all := make([]sample, 0, len(a)+len(b))
+	for _, d := range a {
+		all = append(all, sample{d, true})
+	}
+	for _, d := range b {
+		all = append(all, sample{d, false})
+	}
+	slices.SortFunc(all, func(x, y sample) int { return cmp.Compare(x.d, y.d) })
+
+	// rank the samples, giving tied samples the average of their ranks
+	var rankA, tieCorrection float64
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].d == all[i].d {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for _, s := range all[i:j] {
+			if s.fromA {
+				rankA += rank
+			}
+		}
+		t := float64(j - i)
+		tieCorrection += t*t*t - t
+		i = j
+	}
+
+	n1, n2 := float64(len(a)), float64(len(b))
+	n := n1 + n2
+	u := rankA - n1*(n1+1)/2
+	mean := n1 * n2 / 2
+	sigma := math.Sqrt(n1 * n2 / 12 * ((n + 1) - tieCorrection/(n*(n-1))))
+	if sigma == 0 || math.IsNaN(sigma) {
+		return 1
+	}
+	// continuity correction, moving u half a step towards the mean
+	z := (math.Abs(u-mean) - 0.5) / sigma
+	if z < 0 {
+		return 1
+	}
+	return math.Erfc(z / math.Sqrt2)
+}
+
+// WriteComparison writes a benchstat style table of comparisons between commits a and b
+func WriteComparison(w io.Writer, comparisons []Comparison, a, b string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "day\tinput\tphase\t%s\t%s\tdelta\t\n", a, b)
+	for _, c := range comparisons {
+		delta := "~"
+		if c.P < Alpha {
+			delta = fmt.Sprintf("%+.2f%%", c.Delta*100)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t(p=%.3f n=%d+%d)",
+			c.Day, c.Input, c.Phase, median(c.A), median(c.B), delta, c.P, len(c.A), len(c.B))
+		if c.Slower {
+			fmt.Fprint(tw, "  SLOWER")
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,70 @@
+package aoc
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func durations(ms ...int) []time.Duration {
+	d := make([]time.Duration, len(ms))
+	for i, m := range ms {
+		d[i] = time.Duration(m) * time.Millisecond
+	}
+	return d
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	same := durations(10, 11, 12, 10, 11, 12, 10, 11)
+	require.Equal(t, 1.0, mannWhitneyU(same, same))
+
+	fast := durations(10, 11, 12, 10, 11, 12, 10, 11, 12, 10)
+	slow := durations(20, 21, 22, 20, 21, 22, 20, 21, 22, 20)
+	require.Less(t, mannWhitneyU(fast, slow), 0.001)
+}
+
+func TestCompare(t *testing.T) {
+	history := []HistoryRecord{
+		{Commit: "a", Day: 4, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(10, 11, 12, 10, 11)}},
+		{Commit: "a", Day: 4, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(12, 10, 11, 12, 10)}},
+		{Commit: "b", Day: 4, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(20, 21, 22, 20, 21, 22, 20, 21, 22, 20)}},
+		{Commit: "a", Day: 4, Input: "input", Timing: Timing{Phase: "parse", Samples: durations(5, 5, 6)}},
+		{Commit: "b", Day: 4, Input: "input", Timing: Timing{Phase: "parse", Samples: durations(5, 6, 5)}},
+		{Commit: "b", Day: 5, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(1)}},
+		{Commit: "c", Day: 4, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(1)}},
+		// uncommitted edits on top of a, which aren't a's timings
+		{Commit: "a", Dirty: true, Day: 4, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(50, 50, 50)}},
+		{Commit: "a", Dirty: true, Day: 5, Input: "input", Timing: Timing{Phase: "part1", Samples: durations(1)}},
+	}
+
+	comparisons := Compare(history, "a", "b", 0.1)
+	require.Len(t, comparisons, 2, "day 5 was only benchmarked at b and a dirty a")
+
+	require.Equal(t, "parse", comparisons[0].Phase)
+	require.False(t, comparisons[0].Slower)
+
+	require.Equal(t, "part1", comparisons[1].Phase)
+	require.Len(t, comparisons[1].A, 10, "dirty samples aren't pooled")
+	require.InDelta(t, 0.909, comparisons[1].Delta, 0.001)
+	require.True(t, comparisons[1].Slower)
+
+	require.False(t, Compare(history, "a", "b", 1)[1].Slower, "under the threshold")
+}
+
+func TestHistoryRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), HistoryFile)
+	records, err := LoadHistory(path)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	r := HistoryRecord{Time: time.Date(2025, 12, 4, 0, 0, 0, 0, time.UTC), Commit: "abc", Day: 4, Input: "input",
+		Timing: Timing{Phase: "part1", Runs: 2, Samples: durations(1, 2)}}
+	require.NoError(t, AppendHistory(path, []HistoryRecord{r}))
+	require.NoError(t, AppendHistory(path, []HistoryRecord{r}))
+
+	records, err = LoadHistory(path)
+	require.NoError(t, err)
+	require.Equal(t, []HistoryRecord{r, r}, records)
+}
@@ -0,0 +1,156 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrTimeout is the cause of a part being stopped for running past Limits.Timeout
+	ErrTimeout = errors.New("timed out")
+	// ErrMemoryLimit is the cause of a part being stopped for growing the heap past Limits.Memory
+	ErrMemoryLimit = errors.New("OOM guard: heap grew past the memory limit")
+)
+
+// Limits bound the resources a single part may use. Zero values mean no limit.
+type Limits struct {
+	Timeout time.Duration
+	// Memory is a soft limit in bytes: the GC works harder as the heap approaches it (see
+	// debug.SetMemoryLimit), and the part is stopped if the heap still grows past it
+	Memory int64
+}
+
+// LimitError is returned when a part is stopped for exceeding its Limits
+type LimitError struct {
+	Err     error // ErrTimeout or ErrMemoryLimit
+	Elapsed time.Duration
+	// Goroutines is a dump of every goroutine's stack when the limit was hit, showing where the
+	// part had got to
+	Goroutines []byte
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v after %s", e.Err, e.Elapsed.Round(time.Millisecond))
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// grace is how long a part has to return once its context is cancelled before it's abandoned
+const grace = 100 * time.Millisecond
+
+// memoryPollInterval is how often the heap size is checked against Limits.Memory
+const memoryPollInterval = 10 * time.Millisecond
+
+// SolveLimited is SolveVariant with limits enforced. When a limit is hit the part's context is
+// cancelled with the cause and a LimitError is returned. A part that ignores its context is left
+// running in the background, so the caller should exit soon after.
+func (p *Puzzle) SolveLimited(ctx context.Context, part int, variant string, input any, limits Limits) (any, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	if limits.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, limits.Timeout, ErrTimeout)
+		defer cancelTimeout()
+	}
+	if limits.Memory > 0 {
+		old := debug.SetMemoryLimit(limits.Memory)
+		defer debug.SetMemoryLimit(old)
+	}
+
+	type result struct {
+		answer any
+		err    error
+	}
+	done := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		answer, err := p.SolveVariant(ctx, part, variant, input)
+		done <- result{answer, err}
+	}()
+
+	var poll <-chan time.Time
+	if limits.Memory > 0 {
+		ticker := time.NewTicker(memoryPollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case r := <-done:
+			if cause := context.Cause(ctx); r.err != nil && (errors.Is(cause, ErrTimeout) || errors.Is(cause, ErrMemoryLimit)) {
+				// the part noticed the limit and stopped itself
+				return nil, &LimitError{Err: cause, Elapsed: time.Since(start), Goroutines: goroutines()}
+			}
+			return r.answer, r.err
+		case <-poll:
+			if heapBytes() > uint64(limits.Memory) {
+				cancel(ErrMemoryLimit)
+			}
+		case <-ctx.Done():
+			cause := context.Cause(ctx)
+			if !errors.Is(cause, ErrTimeout) && !errors.Is(cause, ErrMemoryLimit) {
+				// the caller cancelled, so wait for the part like any other result
+				r := <-done
+				return r.answer, r.err
+			}
+			// dump before the part gets a chance to unwind, so it shows where it was stuck
+			limitErr := &LimitError{Err: cause, Elapsed: time.Since(start), Goroutines: goroutines()}
+			select {
+			case <-done:
+			case <-time.After(grace):
+			}
+			return nil, limitErr
+		}
+	}
+}
+
+func goroutines() []byte {
+	var b bytes.Buffer
+	pprof.Lookup("goroutine").WriteTo(&b, 2)
+	return b.Bytes()
+}
+
+func heapBytes() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// ParseBytes parses a size such as "512MiB", "2GB" or "1048576" into bytes
+func ParseBytes(s string) (int64, error) {
+	units := []struct {
+		suffix string
+		size   float64
+	}{
+		{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30}, {"TiB", 1 << 40},
+		{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9}, {"TB", 1e12},
+		{"B", 1},
+	}
+	num, size := s, 1.0
+	for _, u := range units {
+		if n, ok := strings.CutSuffix(s, u.suffix); ok {
+			num, size = n, u.size
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+	if err != nil || f < 0 || f*size > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q, want e.g. 512MiB or 2GB", s)
+	}
+	return int64(f * size), nil
+}
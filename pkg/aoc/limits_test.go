@@ -0,0 +1,57 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBytes(t *testing.T) {
+	for s, want := range map[string]int64{
+		"1048576": 1 << 20,
+		"512MiB":  512 << 20,
+		"2GB":     2e9,
+		"1.5KiB":  1536,
+		"64B":     64,
+	} {
+		got, err := ParseBytes(s)
+		require.NoError(t, err, s)
+		require.Equal(t, want, got, s)
+	}
+
+	for _, s := range []string{"", "MiB", "-1GB", "lots"} {
+		_, err := ParseBytes(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestSolveLimited(t *testing.T) {
+	p := &Puzzle{Day: 99, parts: [2][]variant{
+		{{DefaultVariant, func(ctx context.Context, input any) (any, error) { return input, nil }}},
+		{{DefaultVariant, func(ctx context.Context, input any) (any, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}}},
+	}}
+
+	answer, err := p.SolveLimited(context.Background(), 1, DefaultVariant, 42, Limits{Timeout: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, 42, answer)
+
+	_, err = p.SolveLimited(context.Background(), 2, DefaultVariant, 42, Limits{Timeout: 20 * time.Millisecond})
+	var limitErr *LimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.ErrorIs(t, err, ErrTimeout)
+	require.GreaterOrEqual(t, limitErr.Elapsed, 20*time.Millisecond)
+	require.NotEmpty(t, limitErr.Goroutines)
+
+	// cancelling from outside isn't a limit being hit
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = p.SolveLimited(ctx, 2, DefaultVariant, 42, Limits{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, errors.As(err, &limitErr))
+}
@@ -0,0 +1,200 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+// Status is the outcome of checking one part against its expected answer
+type Status int
+
+const (
+	// Unknown means there is no expected answer stored for the part
+	Unknown Status = iota
+	Pass
+	Fail
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pass:
+		return "PASS"
+	case Fail:
+		return "FAIL"
+	}
+	return "UNKNOWN"
+}
+
+// Check is the result of solving one part of one input and comparing it to the expected answer
+type Check struct {
+	Day      int
+	Input    string // input file name, e.g. "test-input"
+	Part     int
+	Variant  string // which implementation of the part, see RegisterVariant
+	Expected string
+	Actual   string
+	Elapsed  time.Duration // how long the part took to solve, not counting parsing
+	// Err is set if the input couldn't be parsed, the part failed, or a variant disagreed with
+	// the Solver's answer when there's no expected answer to check against. It's always a Fail.
+	Err    error
+	Status Status
+}
+
+// ExampleName is the file name of a day's nth example input: test-input, test-input-2, ...
+func ExampleName(n int) string {
+	if n <= 1 {
+		return "test-input"
+	}
+	return fmt.Sprintf("test-input-%d", n)
+}
+
+// ExampleNumber is the inverse of ExampleName, returning 0 if name isn't an example input
+func ExampleNumber(name string) int {
+	if name == "test-input" {
+		return 1
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(name, "test-input-"))
+	if err != nil || !strings.HasPrefix(name, "test-input-") || n < 2 {
+		return 0
+	}
+	return n
+}
+
+// Inputs lists the inputs present in a day's directory, the real input first (which may only
+// be there encrypted) and then the examples in order. Empty files are left out, as by
+// utils.InputExists.
+func Inputs(dir string) ([]string, error) {
+	var inputs []string
+	if ok, err := utils.InputExists(filepath.Join(dir, utils.InputFile)); err != nil {
+		return nil, err
+	} else if ok {
+		inputs = append(inputs, utils.InputFile)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "test-input*"))
+	if err != nil {
+		return nil, err
+	}
+	var examples []string
+	for _, m := range matches {
+		name := filepath.Base(m)
+		if ExampleNumber(name) == 0 {
+			continue
+		}
+		if ok, err := utils.InputExists(m); err != nil {
+			return nil, err
+		} else if ok {
+			examples = append(examples, name)
+		}
+	}
+	slices.SortFunc(examples, func(a, b string) int { return ExampleNumber(a) - ExampleNumber(b) })
+	return append(inputs, examples...), nil
+}
+
+// Verify solves every variant of both parts of the named input in dir and checks them against
+// answers
+func (p *Puzzle) Verify(ctx context.Context, dir, name string, answers Answers) []Check {
+	var checks []Check
+	for part := 1; part <= len(p.parts); part++ {
+		for _, v := range p.Variants(part) {
+			checks = append(checks, Check{Day: p.Day, Input: name, Part: part, Variant: v, Expected: answers[name].Part(part)})
+		}
+	}
+
+	input, err := p.parseFile(filepath.Join(dir, name))
+	for i := range checks {
+		c := &checks[i]
+		if err == nil {
+			start := time.Now()
+			result, err := p.SolveVariant(ctx, c.Part, c.Variant, input)
+			c.Elapsed = time.Since(start)
+			c.Actual, c.Err = Answer(result), err
+		} else {
+			c.Err = err
+		}
+	}
+	CrossCheck(checks)
+	for i := range checks {
+		checks[i].Grade()
+	}
+	return checks
+}
+
+// CrossCheck sets Err on each variant whose answer differs from the Solver's answer for the
+// same input and part. Variants with an expected answer are left to be checked against that.
+func CrossCheck(checks []Check) {
+	type key struct {
+		day   int
+		input string
+		part  int
+	}
+	defaults := map[key]*Check{}
+	for i, c := range checks {
+		if c.Variant == DefaultVariant {
+			defaults[key{c.Day, c.Input, c.Part}] = &checks[i]
+		}
+	}
+	for i := range checks {
+		c := &checks[i]
+		d, ok := defaults[key{c.Day, c.Input, c.Part}]
+		if !ok || c == d || c.Expected != "" || c.Err != nil || d.Err != nil {
+			continue
+		}
+		if c.Actual != d.Actual {
+			c.Err = fmt.Errorf("answered %s but the %s variant answered %s", c.Actual, DefaultVariant, d.Actual)
+		}
+	}
+}
+
+// Grade sets Status from the outcome of solving the part and the expected answer
+func (c *Check) Grade() {
+	switch {
+	case c.Err != nil:
+		c.Status = Fail
+	case c.Expected == "":
+		c.Status = Unknown
+	case c.Expected == c.Actual:
+		c.Status = Pass
+	default:
+		c.Status = Fail
+	}
+}
+
+func (p *Puzzle) parseFile(path string) (any, error) {
+	b, err := utils.ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+	return p.Parse(bytes.NewReader(b))
+}
+
+// Diff shows how actual differs from expected, line by line for multi-line answers
+func Diff(expected, actual string) string {
+	if !strings.Contains(expected, "\n") && !strings.Contains(actual, "\n") {
+		return fmt.Sprintf("expected: %s\nactual:   %s\n", expected, actual)
+	}
+
+	var b strings.Builder
+	e, a := strings.Split(expected, "\n"), strings.Split(actual, "\n")
+	for i := range max(len(e), len(a)) {
+		if i < len(e) && i < len(a) && e[i] == a[i] {
+			fmt.Fprintf(&b, "  %s\n", e[i])
+			continue
+		}
+		if i < len(e) {
+			fmt.Fprintf(&b, "- %s\n", e[i])
+		}
+		if i < len(a) {
+			fmt.Fprintf(&b, "+ %s\n", a[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,80 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"test-input-10", "input", "test-input", "test-input-2", "test-input.bak", AnswersFile} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("1\n"), 0o644))
+	}
+
+	inputs, err := Inputs(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"input", "test-input", "test-input-2", "test-input-10"}, inputs)
+
+	// empty files are placeholders, not inputs
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "input"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test-input-2"), nil, 0o644))
+	inputs, err = Inputs(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-input", "test-input-10"}, inputs)
+}
+
+func TestExampleNumber(t *testing.T) {
+	for _, n := range []int{1, 2, 10} {
+		require.Equal(t, n, ExampleNumber(ExampleName(n)))
+	}
+	for _, name := range []string{"input", "test-input-1", "test-input-x", "test-input.enc"} {
+		require.Zero(t, ExampleNumber(name), name)
+	}
+}
+
+func TestAnswersRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	answers, err := LoadAnswers(dir)
+	require.NoError(t, err)
+	require.Empty(t, answers)
+
+	e := answers["test-input"]
+	e.Set(2, "14")
+	answers["test-input"] = e
+	require.NoError(t, answers.Save(dir))
+
+	answers, err = LoadAnswers(dir)
+	require.NoError(t, err)
+	require.Equal(t, "", answers["test-input"].Part(1))
+	require.Equal(t, "14", answers["test-input"].Part(2))
+}
+
+func TestDiff(t *testing.T) {
+	require.Equal(t, "expected: 14\nactual:   17\n", Diff("14", "17"))
+	require.Equal(t, "  #.#\n- ###\n+ #.#\n+ ...\n", Diff("#.#\n###", "#.#\n#.#\n..."))
+}
+
+func TestCrossCheck(t *testing.T) {
+	checks := []Check{
+		{Input: "input", Part: 1, Variant: DefaultVariant, Actual: "13"},
+		{Input: "input", Part: 1, Variant: "naive", Actual: "12"},
+		{Input: "input", Part: 2, Variant: DefaultVariant, Actual: "43"},
+		{Input: "input", Part: 2, Variant: "naive", Actual: "43"},
+		// checked against the expected answer instead
+		{Input: "test-input", Part: 1, Variant: DefaultVariant, Actual: "13", Expected: "13"},
+		{Input: "test-input", Part: 1, Variant: "naive", Actual: "12", Expected: "13"},
+	}
+	CrossCheck(checks)
+	for i := range checks {
+		checks[i].Grade()
+	}
+
+	require.ErrorContains(t, checks[1].Err, "answered 12 but the default variant answered 13")
+	require.Equal(t, []Status{Unknown, Fail, Unknown, Unknown, Pass, Fail},
+		[]Status{checks[0].Status, checks[1].Status, checks[2].Status, checks[3].Status, checks[4].Status, checks[5].Status})
+	require.NoError(t, checks[5].Err)
+}
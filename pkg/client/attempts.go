@@ -0,0 +1,123 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// AttemptsFile is where every submitted answer is logged, relative to the repo root
+const AttemptsFile = ".aoc/submissions.jsonl"
+
+// Attempt is one submitted answer and what the site said about it
+type Attempt struct {
+	Time    time.Time     `json:"time"`
+	Day     int           `json:"day"`
+	Part    int           `json:"part"`
+	Answer  string        `json:"answer"`
+	Verdict Verdict       `json:"verdict"`
+	Hint    string        `json:"hint,omitempty"`
+	Wait    time.Duration `json:"wait_ns,omitempty"`
+}
+
+// AppendAttempt adds a to the end of the attempts log at path
+func AppendAttempt(path string, a Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(a); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadAttempts reads every attempt in the log at path. A missing file means nothing has been
+// submitted yet.
+func LoadAttempts(path string) ([]Attempt, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var attempts []Attempt
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		var a Attempt
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, scanner.Err()
+}
+
+var (
+	// ErrKnownWrong is returned by CheckAttempt for an answer the site has already rejected
+	ErrKnownWrong = errors.New("answer is known to be wrong")
+	// ErrAlreadySolved is returned by CheckAttempt for a part that's already been answered correctly
+	ErrAlreadySolved = errors.New("part is already solved")
+)
+
+// CooldownError is returned by CheckAttempt when the site asked for a wait that isn't over yet
+type CooldownError struct {
+	Until time.Time
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("the site asked to wait until %s before answering again", e.Until.Local().Format(time.TimeOnly))
+}
+
+// CheckAttempt returns an error if answer shouldn't be submitted for part of day, given the
+// earlier attempts: the part is solved, the answer was already rejected or is ruled out by a too
+// high or too low hint, or the last submission's wait isn't over.
+func CheckAttempt(attempts []Attempt, day, part int, answer string, now time.Time) error {
+	n, err := strconv.ParseInt(answer, 10, 64)
+	numeric := err == nil
+	var until time.Time
+	for _, a := range attempts {
+		if end := a.Time.Add(a.Wait); end.After(until) {
+			until = end
+		}
+		if a.Day != day || a.Part != part {
+			continue
+		}
+		switch {
+		case a.Verdict == Correct && a.Answer == answer:
+			return fmt.Errorf("%w, with %s", ErrAlreadySolved, a.Answer)
+		case a.Verdict == Correct:
+			return fmt.Errorf("%w, with %s not %s", ErrAlreadySolved, a.Answer, answer)
+		case a.Verdict != Incorrect:
+			continue
+		case a.Answer == answer:
+			return fmt.Errorf("%w: %s was rejected at %s", ErrKnownWrong, answer, a.Time.Format(time.DateTime))
+		}
+
+		// a hint rules out every answer past the rejected one
+		prev, err := strconv.ParseInt(a.Answer, 10, 64)
+		if !numeric || err != nil {
+			continue
+		}
+		if (a.Hint == "too high" && n >= prev) || (a.Hint == "too low" && n <= prev) {
+			return fmt.Errorf("%w: %s was %s", ErrKnownWrong, a.Answer, a.Hint)
+		}
+	}
+	if now.Before(until) {
+		return &CooldownError{Until: until}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Package client talks to the Advent of Code website. Every request is made as the user whose
+// session token the client is given, and requests are spaced out so the site isn't hammered.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+var (
+	// ErrNoSession is returned if a request is made without a session token
+	ErrNoSession = errors.New("no session token, set AOC_SESSION or session in the config file")
+	// ErrNotUnlocked is returned for a puzzle that hasn't been released yet (404)
+	ErrNotUnlocked = errors.New("puzzle is not unlocked yet")
+	// ErrBadSession is returned when the site rejects the session token (400)
+	ErrBadSession = errors.New("session token was rejected, log in again and copy the new session cookie")
+)
+
+// StatusError is returned for any other response that isn't 200 OK
+type StatusError struct {
+	Status string
+	Body   string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected response %s: %s", e.Status, e.Body)
+}
+
+// DefaultInterval is the least time left between requests
+const DefaultInterval = 5 * time.Second
+
+// UserAgent identifies the tool to the site's maintainers, as they ask automated tools to
+const UserAgent = "github.com/josiemessa/aoc2025"
+
+// Client makes requests to the site for one year's puzzles
+type Client struct {
+	BaseURL string
+	Year    int
+	Session string
+	// Contact, e.g. an email address, is added to the User-Agent
+	Contact string
+	// Interval is the least time left between requests, DefaultInterval if zero
+	Interval time.Duration
+	HTTP     *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// New returns a client for the year's puzzles on the site at baseURL
+func New(baseURL string, year int, session string) *Client {
+	return &Client{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Year:    year,
+		Session: session,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Input downloads a day's puzzle input
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("/%d/day/%d/input", c.Year, day))
+}
+
+// CacheInput downloads a day's puzzle input to path, unless it's already there, either plain or
+// as its encrypted copy (see utils.InputExists). It reports whether the input was downloaded.
+func (c *Client) CacheInput(ctx context.Context, day int, path string) (bool, error) {
+	if ok, err := utils.InputExists(path); ok || err != nil {
+		return false, err
+	}
+
+	input, err := c.Input(ctx, day)
+	if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+	// write to a temporary file first so an interrupted write doesn't look like a cached input
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, input, 0o644); err != nil {
+		return false, err
+	}
+	return true, os.Rename(tmp, path)
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	ua := UserAgent
+	if c.Contact != "" {
+		ua += " by " + c.Contact
+	}
+	req.Header.Set("User-Agent", ua)
+	return req, nil
+}
+
+// do sends req once enough time has passed since the last request, and returns the body of a
+// 200 OK response
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if err := c.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, ErrNotUnlocked
+	case http.StatusBadRequest:
+		return nil, ErrBadSession
+	}
+	return nil, &StatusError{Status: resp.Status, Body: strings.TrimSpace(string(body))}
+}
+
+// wait blocks until Interval has passed since the previous request
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	interval := c.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	if d := time.Until(c.last.Add(interval)); d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	c.last = time.Now()
+	return nil
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// stub is a stand-in for the site that serves day 1's input to the "good" session
+func stub(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2025/day/{day}/input", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !strings.HasPrefix(r.UserAgent(), UserAgent) {
+			http.Error(w, "no User-Agent", http.StatusForbidden)
+			return
+		}
+		if c, err := r.Cookie("session"); err != nil || c.Value != "good" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.PathValue("day") == "3" {
+			http.Error(w, "oops", http.StatusInternalServerError)
+			return
+		}
+		if r.PathValue("day") != "1" {
+			http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("L68\nL30\n"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newTestClient(url, session string) *Client {
+	c := New(url, 2025, session)
+	c.Interval = time.Millisecond
+	return c
+}
+
+func TestInput(t *testing.T) {
+	srv, _ := stub(t)
+
+	input, err := newTestClient(srv.URL, "good").Input(t.Context(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "L68\nL30\n", string(input))
+
+	_, err = newTestClient(srv.URL, "good").Input(t.Context(), 2)
+	require.ErrorIs(t, err, ErrNotUnlocked)
+
+	_, err = newTestClient(srv.URL, "stale").Input(t.Context(), 1)
+	require.ErrorIs(t, err, ErrBadSession)
+
+	_, err = newTestClient(srv.URL, "").Input(t.Context(), 1)
+	require.ErrorIs(t, err, ErrNoSession)
+
+	_, err = newTestClient(srv.URL, "good").Input(t.Context(), 3)
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, "500 Internal Server Error", statusErr.Status)
+	require.Equal(t, "oops", statusErr.Body)
+}
+
+func TestCacheInput(t *testing.T) {
+	srv, requests := stub(t)
+	c := newTestClient(srv.URL, "good")
+	path := filepath.Join(t.TempDir(), "day1", "input")
+
+	fetched, err := c.CacheInput(t.Context(), 1, path)
+	require.NoError(t, err)
+	require.True(t, fetched)
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "L68\nL30\n", string(b))
+
+	fetched, err = c.CacheInput(t.Context(), 1, path)
+	require.NoError(t, err)
+	require.False(t, fetched)
+	require.Equal(t, int32(1), requests.Load())
+
+	// an empty file is only a placeholder
+	path = filepath.Join(filepath.Dir(path), "..", "new", "input")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+	fetched, err = c.CacheInput(t.Context(), 1, path)
+	require.NoError(t, err)
+	require.True(t, fetched)
+	b, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "L68\nL30\n", string(b))
+	require.Equal(t, int32(2), requests.Load())
+
+	// an encrypted copy is already cached
+	path = filepath.Join(filepath.Dir(path), "..", "encrypted", "input")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path+utils.EncryptedSuffix, []byte("ciphertext"), 0o644))
+	fetched, err = c.CacheInput(t.Context(), 1, path)
+	require.NoError(t, err)
+	require.False(t, fetched)
+	require.NoFileExists(t, path)
+	require.Equal(t, int32(2), requests.Load())
+
+	// nothing is cached from a failed request
+	path = filepath.Join(filepath.Dir(path), "..", "day2", "input")
+	_, err = c.CacheInput(t.Context(), 2, path)
+	require.ErrorIs(t, err, ErrNotUnlocked)
+	require.NoFileExists(t, path)
+}
+
+func TestInterval(t *testing.T) {
+	srv, _ := stub(t)
+	c := newTestClient(srv.URL, "good")
+	c.Interval = 50 * time.Millisecond
+
+	start := time.Now()
+	for range 3 {
+		_, err := c.Input(t.Context(), 1)
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
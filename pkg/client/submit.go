@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the site's response to a submitted answer
+type Verdict int
+
+const (
+	Correct Verdict = iota
+	Incorrect
+	// TooSoon means the answer wasn't checked as the previous submission was too recent
+	TooSoon
+	// WrongLevel means the part is already solved or not unlocked yet
+	WrongLevel
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Correct:
+		return "correct"
+	case Incorrect:
+		return "incorrect"
+	case TooSoon:
+		return "too soon"
+	case WrongLevel:
+		return "wrong level"
+	}
+	return fmt.Sprintf("Verdict(%d)", int(v))
+}
+
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Verdict) UnmarshalText(b []byte) error {
+	for _, candidate := range []Verdict{Correct, Incorrect, TooSoon, WrongLevel} {
+		if candidate.String() == string(b) {
+			*v = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", b)
+}
+
+// Result is what the site said about a submitted answer
+type Result struct {
+	Verdict Verdict
+	// Hint is "too high" or "too low" if the site said which way an incorrect answer was wrong
+	Hint string
+	// Wait is how long until another answer can be submitted
+	Wait time.Duration
+	// Message is the text of the response
+	Message string
+}
+
+// Submit sends answer for part of a day's puzzle
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Result, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", c.Year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body, err := c.do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	return parseResult(string(body))
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+	// "You have 1m 3s left to wait." after answering too soon
+	leftPattern = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	// "please wait one minute before trying again." after an incorrect answer
+	waitPattern = regexp.MustCompile(`(?i)please wait (\w+) minutes?`)
+	hintPattern = regexp.MustCompile(`your answer is (too high|too low)`)
+)
+
+// parseResult reads the verdict from the page the site responds to an answer with
+func parseResult(page string) (Result, error) {
+	m := articlePattern.FindStringSubmatch(page)
+	if m == nil {
+		return Result{}, fmt.Errorf("no message in response: %.200q", page)
+	}
+	msg := strings.TrimSpace(spacePattern.ReplaceAllString(html.UnescapeString(tagPattern.ReplaceAllString(m[1], "")), " "))
+	r := Result{Message: msg}
+
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		r.Verdict = Correct
+	case strings.Contains(msg, "That's not the right answer"):
+		r.Verdict = Incorrect
+		if h := hintPattern.FindStringSubmatch(msg); h != nil {
+			r.Hint = h[1]
+		}
+	case strings.Contains(msg, "You gave an answer too recently"):
+		r.Verdict = TooSoon
+	case strings.Contains(msg, "You don't seem to be solving the right level"):
+		r.Verdict = WrongLevel
+	default:
+		return Result{}, fmt.Errorf("unrecognised response: %s", msg)
+	}
+
+	if w := leftPattern.FindStringSubmatch(msg); w != nil {
+		minutes, _ := strconv.Atoi(w[1])
+		seconds, _ := strconv.Atoi(w[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if w := waitPattern.FindStringSubmatch(msg); w != nil {
+		minutes, err := strconv.Atoi(w[1])
+		if err != nil {
+			// the site spells out a single minute
+			minutes = 1
+		}
+		r.Wait = time.Duration(minutes) * time.Minute
+	}
+	return r, nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// page wraps a message the way the site does in its response to an answer
+func page(msg string) string {
+	return `<!DOCTYPE html><html><body><main><article><p>` + msg + `</p></article></main></body></html>`
+}
+
+func TestParseResult(t *testing.T) {
+	for name, tc := range map[string]struct {
+		msg  string
+		want Result
+	}{
+		"correct": {
+			`That's the right answer!  You are <span class="day-success">one gold star</span> closer. <a href="/2025/day/4#part2">[Continue to Part Two]</a>`,
+			Result{Verdict: Correct},
+		},
+		"too high": {
+			`That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2025/day/4">[Return to Day 4]</a>`,
+			Result{Verdict: Incorrect, Hint: "too high", Wait: time.Minute},
+		},
+		"no hint": {
+			`That's not the right answer.  Because you have guessed incorrectly 5 times on this puzzle, please wait 5 minutes before trying again.`,
+			Result{Verdict: Incorrect, Wait: 5 * time.Minute},
+		},
+		"too soon": {
+			`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 3s left to wait.`,
+			Result{Verdict: TooSoon, Wait: time.Minute + 3*time.Second},
+		},
+		"wrong level": {
+			`You don't seem to be solving the right level.  Did you already complete it?`,
+			Result{Verdict: WrongLevel},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := parseResult(page(tc.msg))
+			require.NoError(t, err)
+			require.NotEmpty(t, r.Message)
+			r.Message = ""
+			require.Equal(t, tc.want, r)
+		})
+	}
+
+	_, err := parseResult("<html>maintenance</html>")
+	require.Error(t, err)
+}
+
+func TestSubmit(t *testing.T) {
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2025/day/4/answer", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.FormValue("level") == "1" && r.FormValue("answer") == "13" {
+			w.Write([]byte(page("That's the right answer!")))
+			return
+		}
+		w.Write([]byte(page("That's not the right answer; your answer is too low. Please wait one minute before trying again.")))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	c := newTestClient(srv.URL, "good")
+
+	r, err := c.Submit(t.Context(), 4, 1, "13")
+	require.NoError(t, err)
+	require.Equal(t, Correct, r.Verdict)
+
+	r, err = c.Submit(t.Context(), 4, 2, "40")
+	require.NoError(t, err)
+	require.Equal(t, Result{Verdict: Incorrect, Hint: "too low", Wait: time.Minute, Message: r.Message}, r)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestAttempts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), AttemptsFile)
+	start := time.Date(2025, 12, 4, 5, 0, 0, 0, time.UTC)
+	for _, a := range []Attempt{
+		{Time: start, Day: 4, Part: 1, Answer: "13", Verdict: Correct},
+		{Time: start.Add(time.Hour), Day: 4, Part: 2, Answer: "40", Verdict: Incorrect, Hint: "too low", Wait: time.Minute},
+	} {
+		require.NoError(t, AppendAttempt(path, a))
+	}
+	attempts, err := LoadAttempts(path)
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	require.Equal(t, Incorrect, attempts[1].Verdict)
+
+	later := start.Add(2 * time.Hour)
+	require.ErrorIs(t, CheckAttempt(attempts, 4, 1, "13", later), ErrAlreadySolved)
+	require.ErrorIs(t, CheckAttempt(attempts, 4, 2, "40", later), ErrKnownWrong)
+	require.ErrorIs(t, CheckAttempt(attempts, 4, 2, "39", later), ErrKnownWrong)
+	require.NoError(t, CheckAttempt(attempts, 4, 2, "43", later))
+	require.NoError(t, CheckAttempt(attempts, 5, 1, "3", later))
+
+	var cooldown *CooldownError
+	require.ErrorAs(t, CheckAttempt(attempts, 4, 2, "43", start.Add(time.Hour+30*time.Second)), &cooldown)
+	require.Equal(t, start.Add(time.Hour+time.Minute), cooldown.Until)
+}
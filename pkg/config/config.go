@@ -0,0 +1,80 @@
+// Package config loads the user's settings for talking to the Advent of Code website. They live
+// outside the repo as the session token and input key are secrets.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Year is the event the repo's solutions are for
+const Year = 2025
+
+// DefaultBaseURL is the Advent of Code website
+const DefaultBaseURL = "https://adventofcode.com"
+
+// Config holds the settings read from the config file, overridden by the environment
+type Config struct {
+	// Session is the value of the session cookie from a logged in browser (AOC_SESSION)
+	Session string `json:"session,omitempty"`
+	// BaseURL is where the website is, changed to test against a stand-in (AOC_BASE_URL)
+	BaseURL string `json:"base_url,omitempty"`
+	Year    int    `json:"year,omitempty"` // AOC_YEAR
+	// Contact is added to the User-Agent so the site's maintainers can get in touch (AOC_CONTACT)
+	Contact string `json:"contact,omitempty"`
+	// InputKey decrypts the puzzle inputs committed to the repo, base64 encoded (AOC_INPUT_KEY)
+	InputKey string `json:"input_key,omitempty"`
+}
+
+// Path is the config file, $AOC_CONFIG if set or aoc2025/config.json in the user's config
+// directory, e.g. ~/.config/aoc2025/config.json
+func Path() (string, error) {
+	if path := os.Getenv("AOC_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc2025", "config.json"), nil
+}
+
+// Load reads the config file, if there is one, and applies the environment over it
+func Load() (Config, error) {
+	c := Config{BaseURL: DefaultBaseURL, Year: Year}
+	path, err := Path()
+	if err != nil {
+		return Config{}, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Config{}, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &c); err != nil {
+			return Config{}, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	for env, dst := range map[string]*string{
+		"AOC_SESSION":   &c.Session,
+		"AOC_BASE_URL":  &c.BaseURL,
+		"AOC_CONTACT":   &c.Contact,
+		"AOC_INPUT_KEY": &c.InputKey,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*dst = v
+		}
+	}
+	if v := os.Getenv("AOC_YEAR"); v != "" {
+		if c.Year, err = strconv.Atoi(v); err != nil {
+			return Config{}, fmt.Errorf("AOC_YEAR: %w", err)
+		}
+	}
+	return c, nil
+}
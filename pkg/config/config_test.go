@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("AOC_CONFIG", path)
+	for _, env := range []string{"AOC_SESSION", "AOC_BASE_URL", "AOC_CONTACT", "AOC_YEAR", "AOC_INPUT_KEY"} {
+		t.Setenv(env, "")
+	}
+
+	c, err := Load()
+	require.NoError(t, err)
+	require.Equal(t, Config{BaseURL: DefaultBaseURL, Year: Year}, c)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"session": "from-file", "contact": "me@example.com"}`), 0o600))
+	c, err = Load()
+	require.NoError(t, err)
+	require.Equal(t, "from-file", c.Session)
+	require.Equal(t, "me@example.com", c.Contact)
+
+	t.Setenv("AOC_SESSION", "from-env")
+	t.Setenv("AOC_YEAR", "2024")
+	c, err = Load()
+	require.NoError(t, err)
+	require.Equal(t, "from-env", c.Session)
+	require.Equal(t, 2024, c.Year)
+}
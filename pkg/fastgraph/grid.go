@@ -22,6 +22,18 @@ type Grid struct {
 	decode       func(uint8) rune
 }
 
+// TilesPerByte is the most tiles LinesToGrid can pack into a byte for an alphabet of n distinct
+// tiles, or 0 if n doesn't fit in a byte. Out-of-bounds cells read as 0, so count an extra tile
+// if that has to be told apart from every tile in the grid.
+func TilesPerByte(n int) int {
+	for _, tiles := range []int{8, 4, 2, 1} {
+		if n <= 1<<(8/tiles) {
+			return tiles
+		}
+	}
+	return 0
+}
+
 // tilesPerByte must be 8, 4, 2 or 1
 func LinesToGrid(lines []string, tilesPerByte int, encode func(rune) uint8, decode func(uint8) rune) Grid {
 	g := Grid{
@@ -184,3 +184,9 @@ func TestGetCellTile4Tiles(t *testing.T) {
 	require.Equal(t, uint8(0b10), grid.GetCellTile(GridCoord{4, 2}), "coord [4, 2]")
 	require.Equal(t, uint8(0b00), grid.GetCellTile(GridCoord{100, 100}), "coord [100,100] (out of bounds)")
 }
+
+func TestTilesPerByte(t *testing.T) {
+	for n, want := range map[int]int{1: 8, 2: 8, 3: 4, 4: 4, 5: 2, 16: 2, 17: 1, 256: 1, 257: 0} {
+		require.Equal(t, want, TilesPerByte(n), "%d tiles", n)
+	}
+}
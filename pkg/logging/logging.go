@@ -0,0 +1,168 @@
+// Package logging sets up leveled log/slog loggers whose level can be set per component, so
+// one day or shared package can log in detail without drowning in everything else's output.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// LevelTrace is below slog.LevelDebug, for logging on every step of a loop
+const LevelTrace = slog.LevelDebug - 4
+
+// ComponentKey is the attribute naming which day or package a logger belongs to
+const ComponentKey = "component"
+
+// Levels is the minimum level logged for each component, falling back to Default
+type Levels struct {
+	Default    slog.Level
+	Components map[string]slog.Level
+}
+
+// Level is the minimum level logged for component
+func (l Levels) Level(component string) slog.Level {
+	if level, ok := l.Components[component]; ok {
+		return level
+	}
+	return l.Default
+}
+
+// ParseLevels parses a comma separated list of component=level settings, e.g.
+// "slowgraph=debug,day4=trace", onto l. A level without a component sets the default.
+func (l *Levels) ParseLevels(spec string) error {
+	for setting := range strings.SplitSeq(spec, ",") {
+		if setting = strings.TrimSpace(setting); setting == "" {
+			continue
+		}
+		component, name, ok := strings.Cut(setting, "=")
+		if !ok {
+			component, name = "", setting
+		}
+		level, err := ParseLevel(name)
+		if err != nil {
+			return err
+		}
+		if component == "" {
+			l.Default = level
+			continue
+		}
+		if l.Components == nil {
+			l.Components = map[string]slog.Level{}
+		}
+		l.Components[component] = level
+	}
+	return nil
+}
+
+// ParseLevel parses a level name: trace, debug, info, warn or error
+func ParseLevel(name string) (slog.Level, error) {
+	if strings.EqualFold(name, "trace") {
+		return LevelTrace, nil
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q, want trace, debug, info, warn or error", name)
+	}
+	return level, nil
+}
+
+// New returns a logger writing text to w, filtered by the level of each logger's component
+func New(w io.Writer, levels Levels) *slog.Logger {
+	h := slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: slog.Level(-1 << 10), // filtering is done by Handler
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) > 0 {
+				return a
+			}
+			switch a.Key {
+			case slog.TimeKey:
+				return slog.Attr{}
+			case slog.LevelKey:
+				if a.Value.Any().(slog.Level) == LevelTrace {
+					a.Value = slog.StringValue("TRACE")
+				}
+			}
+			return a
+		},
+	})
+	return slog.New(&Handler{handler: h, levels: levels, level: levels.Default})
+}
+
+// Handler drops records below the level set for the component of the logger they came from.
+// The component is added to each record rather than to the logger, so that a shared package
+// handed a day's logger replaces the day's component instead of adding a second one.
+type Handler struct {
+	handler   slog.Handler
+	levels    Levels
+	level     slog.Level
+	component string
+}
+
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	if h.component != "" {
+		r2 := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+		r2.AddAttrs(slog.String(ComponentKey, h.component))
+		r.Attrs(func(a slog.Attr) bool {
+			r2.AddAttrs(a)
+			return true
+		})
+		r = r2
+	}
+	return h.handler.Handle(ctx, r)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	var rest []slog.Attr
+	for _, a := range attrs {
+		if a.Key == ComponentKey {
+			h2.component = a.Value.String()
+			h2.level = h.levels.Level(h2.component)
+			continue
+		}
+		rest = append(rest, a)
+	}
+	if len(rest) > 0 {
+		h2.handler = h.handler.WithAttrs(rest)
+	}
+	return &h2
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	h2 := *h
+	h2.handler = h.handler.WithGroup(name)
+	return &h2
+}
+
+// Component returns l for logging from the named component, e.g. "day4" or "slowgraph"
+func Component(l *slog.Logger, name string) *slog.Logger {
+	return l.With(ComponentKey, name)
+}
+
+// Trace logs at LevelTrace
+func Trace(l *slog.Logger, msg string, args ...any) {
+	l.Log(context.Background(), LevelTrace, msg, args...)
+}
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx that carries l, which is how solvers and the shared packages
+// they call are handed a logger
+func NewContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx, or slog.Default() if there isn't one
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevels(t *testing.T) {
+	levels := Levels{Default: slog.LevelInfo}
+	require.NoError(t, levels.ParseLevels("slowgraph=debug, day4=trace"))
+	require.Equal(t, slog.LevelInfo, levels.Level("day1"))
+	require.Equal(t, slog.LevelDebug, levels.Level("slowgraph"))
+	require.Equal(t, LevelTrace, levels.Level("day4"))
+
+	require.NoError(t, levels.ParseLevels("warn"))
+	require.Equal(t, slog.LevelWarn, levels.Level("day1"))
+
+	require.ErrorContains(t, levels.ParseLevels("day4=loud"), `unknown log level "loud"`)
+}
+
+func TestComponentLevels(t *testing.T) {
+	var b bytes.Buffer
+	logger := New(&b, Levels{Default: slog.LevelInfo, Components: map[string]slog.Level{"day4": LevelTrace}})
+
+	day4 := Component(logger, "day4")
+	Trace(day4, "visit", "x", 1)
+	Component(day4, "slowgraph").Debug("flood fill done")
+	Component(logger, "day5").Debug("found")
+
+	require.Equal(t, "level=TRACE msg=visit component=day4 x=1\n", b.String())
+}
+
+func TestFromContext(t *testing.T) {
+	require.Equal(t, slog.Default(), FromContext(context.Background()))
+
+	logger := New(&bytes.Buffer{}, Levels{})
+	require.Equal(t, logger, FromContext(NewContext(context.Background(), logger)))
+}
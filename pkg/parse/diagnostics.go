@@ -0,0 +1,133 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Error is a problem with one line of the input
+type Error struct {
+	Section int // 1-indexed, 0 if the input wasn't split into sections
+	Line    int // 1-indexed line number in the whole input
+	Column  int // 1-indexed byte column the problem starts at, 0 if it's the whole line
+	Span    int // number of bytes at fault from Column
+	Text    string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	var loc strings.Builder
+	if e.Section > 0 {
+		fmt.Fprintf(&loc, "section %d, ", e.Section)
+	}
+	fmt.Fprintf(&loc, "line %d", e.Line)
+	if e.Column > 0 {
+		fmt.Fprintf(&loc, ", column %d", e.Column)
+	}
+	return fmt.Sprintf("%s %q: %v", loc.String(), e.Text, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostics collects every parse error in an input so they can all be reported at once,
+// rather than stopping at the first bad line
+type Diagnostics []*Error
+
+// Add records err against a line of the input. column is the 1-indexed column where the part of
+// the line that err is about starts, or 0 if it's about the whole line. If err is a MatchError
+// its column is taken as relative to that.
+func (d *Diagnostics) Add(line, column int, text string, err error) {
+	e := &Error{Line: line, Column: column, Text: text, Err: err}
+	var merr *MatchError
+	if errors.As(err, &merr) {
+		e.Column = max(column, 1) + merr.Column - 1
+		e.Span = merr.Span
+		e.Err = merr.Err
+		if merr.Field != "" {
+			e.Err = fmt.Errorf("field %q: %w", merr.Field, merr.Err)
+		}
+	}
+	*d = append(*d, e)
+}
+
+// Merge appends the diagnostics in err, returning nil, so several parsers can report into one
+// Diagnostics. Any other error is returned as is so the caller can stop early.
+func (d *Diagnostics) Merge(err error) error {
+	var other Diagnostics
+	if errors.As(err, &other) {
+		*d = append(*d, other...)
+		return nil
+	}
+	return err
+}
+
+// Err returns d as an error, or nil if nothing was reported
+func (d Diagnostics) Err() error {
+	if len(d) == 0 {
+		return nil
+	}
+	return d
+}
+
+func (d Diagnostics) Error() string {
+	if len(d) == 1 {
+		return d[0].Error()
+	}
+	return fmt.Sprintf("%d parse errors, first: %v", len(d), d[0])
+}
+
+func (d Diagnostics) Unwrap() []error {
+	errs := make([]error, len(d))
+	for i, e := range d {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Format writes every error compiler-style, showing the offending line with a caret under the
+// bad spot:
+//
+//	day1/input:3:3: expected integer, found "x"
+//	    3 | L5x
+//	      |   ^
+func (d Diagnostics) Format(w io.Writer, name string) {
+	for _, e := range d {
+		if e.Column > 0 {
+			fmt.Fprintf(w, "%s:%d:%d: ", name, e.Line, e.Column)
+		} else {
+			fmt.Fprintf(w, "%s:%d: ", name, e.Line)
+		}
+		if e.Section > 0 {
+			fmt.Fprintf(w, "section %d: ", e.Section)
+		}
+		fmt.Fprintln(w, e.Err)
+
+		gutter := fmt.Sprintf("%5d | ", e.Line)
+		fmt.Fprintf(w, "%s%s\n", gutter, e.Text)
+		fmt.Fprintf(w, "%s| %s\n", strings.Repeat(" ", len(gutter)-2), underline(e))
+	}
+}
+
+// underline places carets under the bytes of e.Text at fault, keeping any tabs before them so
+// the carets line up in a terminal
+func underline(e *Error) string {
+	start, span := 0, len(e.Text)
+	if e.Column > 0 {
+		start = min(e.Column-1, len(e.Text))
+		span = max(e.Span, 1)
+	}
+	var b strings.Builder
+	for i := range start {
+		if e.Text[i] == '\t' {
+			b.WriteByte('\t')
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	b.WriteString(strings.Repeat("^", max(span, 1)))
+	return b.String()
+}
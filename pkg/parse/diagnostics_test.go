@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsCollectsEveryError(t *testing.T) {
+	s := Section{Index: 0, Start: 1, Lines: []string{"1-2,3-x", "4-5", "y-6"}}
+	_, err := s.Ranges()
+
+	var diags Diagnostics
+	require.True(t, errors.As(err, &diags))
+	require.Len(t, diags, 2)
+	require.Equal(t, 1, diags[0].Line)
+	require.Equal(t, 7, diags[0].Column)
+	require.Equal(t, 3, diags[1].Line)
+	require.Equal(t, 1, diags[1].Column)
+}
+
+func TestDiagnosticsFormat(t *testing.T) {
+	var diags Diagnostics
+	var dir string
+	var n int
+	diags.Add(3, 0, "L5x", MustCompile("{dir:R|L}{n:int}").Scan("L5x", &dir, &n))
+	diags.Add(4, 0, "\tabc", strconv.ErrSyntax)
+	diags.Add(7, 1, "R99999999999999999999", MustCompile("{dir:R|L}{n:int}").Scan("R99999999999999999999", &dir, &n))
+
+	var out strings.Builder
+	diags.Format(&out, "day1/input")
+	require.Equal(t, `day1/input:3:3: unexpected "x" after end of pattern
+    3 | L5x
+      |   ^
+day1/input:4: invalid syntax
+    4 | 	abc
+      | ^^^^
+day1/input:7:2: field "n": strconv.ParseInt: parsing "99999999999999999999": value out of range
+    7 | R99999999999999999999
+      |  ^^^^^^^^^^^^^^^^^^^^
+`, out.String())
+
+	require.ErrorContains(t, diags.Err(), "3 parse errors")
+	require.NoError(t, Diagnostics(nil).Err())
+}
@@ -0,0 +1,245 @@
+package parse
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/josiemessa/aoc2025/pkg/fastgraph"
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+// Summary describes the shape of an input, to help pick how to parse it
+type Summary struct {
+	Bytes int
+	Lines int
+	// MinWidth and MaxWidth are the shortest and longest non-blank lines, in bytes
+	MinWidth, MaxWidth int
+	// Normalization is what utils.Normalize would change, e.g. CRLF line endings
+	Normalization utils.Normalization
+	Sections      []SectionSummary
+	// Chars counts each byte other than newlines, most common first
+	Chars []Count[byte]
+	Ints  IntSummary
+	// Patterns are the shapes of the lines, written as a Pattern with every integer replaced by
+	// an unnamed int field {:int}, most common first
+	Patterns []Count[string]
+}
+
+// Count is how many times a value appears
+type Count[T any] struct {
+	Value T
+	N     int
+}
+
+// SectionSummary describes one of the input's blank-line separated sections
+type SectionSummary struct {
+	Section
+	MinWidth, MaxWidth int
+	// Rectangular is set for sections of more than one line that are all the same width
+	Rectangular bool
+	// Alphabet is the distinct bytes in a rectangular section
+	Alphabet []byte
+	// TilesPerByte is the most a rectangular section's alphabet can be packed into with
+	// fastgraph.LinesToGrid, 0 if it can't. Out-of-bounds counts as a tile of its own, so it
+	// can be told apart from every tile in the grid.
+	TilesPerByte int
+}
+
+// IntSummary describes the integers found in the input, as by ExtractInts
+type IntSummary struct {
+	Count     int
+	Negatives int
+	Min, Max  *big.Int
+}
+
+// Fits names the narrowest Go integer type that holds every integer, or "" if none does
+func (s IntSummary) Fits() string {
+	if s.Count == 0 {
+		return ""
+	}
+	for _, t := range []struct {
+		name     string
+		min, max *big.Int
+	}{
+		{"int32", big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32)},
+		{"int64", big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+		{"uint64", big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+	} {
+		if s.Min.Cmp(t.min) >= 0 && s.Max.Cmp(t.max) <= 0 {
+			return t.name
+		}
+	}
+	return ""
+}
+
+// Inspect summarises input
+func Inspect(input []byte) Summary {
+	s := Summary{Bytes: len(input)}
+	// the rest is of the normalized input, so line endings and tabs don't skew the counts
+	normalized, n := utils.Normalize(input)
+	s.Normalization = n
+
+	var lines []string
+	if text := strings.TrimSuffix(string(normalized), "\n"); text != "" {
+		lines = strings.Split(text, "\n")
+	}
+	s.Lines = len(lines)
+
+	chars := map[byte]int{}
+	patterns := map[string]int{}
+	s.MinWidth = math.MaxInt
+	for _, line := range lines {
+		for i := range len(line) {
+			chars[line[i]]++
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		s.MinWidth = min(s.MinWidth, len(line))
+		s.MaxWidth = max(s.MaxWidth, len(line))
+		patterns[s.Ints.add(line)]++
+	}
+	if s.MaxWidth == 0 {
+		s.MinWidth = 0
+	}
+	s.Chars = counts(chars)
+	s.Patterns = counts(patterns)
+
+	for _, sec := range Sections(lines) {
+		s.Sections = append(s.Sections, inspectSection(sec))
+	}
+	return s
+}
+
+// add records the integers in line and returns its pattern
+func (s *IntSummary) add(line string) string {
+	var b strings.Builder
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		start := i
+		if c == '-' && i+1 < len(line) && isDigit(line[i+1]) && (i == 0 || !isDigit(line[i-1])) {
+			i++
+		}
+		if !isDigit(line[i]) {
+			if c == '{' || c == '}' {
+				b.WriteByte(c) // escaped as in a Pattern
+			}
+			b.WriteByte(c)
+			continue
+		}
+		for i+1 < len(line) && isDigit(line[i+1]) {
+			i++
+		}
+
+		n, _ := new(big.Int).SetString(line[start:i+1], 10)
+		if s.Count == 0 {
+			s.Min, s.Max = n, n
+		}
+		if n.Cmp(s.Min) < 0 {
+			s.Min = n
+		}
+		if n.Cmp(s.Max) > 0 {
+			s.Max = n
+		}
+		if n.Sign() < 0 {
+			s.Negatives++
+		}
+		s.Count++
+		b.WriteString("{:int}")
+	}
+	return b.String()
+}
+
+func inspectSection(sec Section) SectionSummary {
+	ss := SectionSummary{Section: sec, MinWidth: math.MaxInt}
+	for _, line := range sec.Lines {
+		ss.MinWidth = min(ss.MinWidth, len(line))
+		ss.MaxWidth = max(ss.MaxWidth, len(line))
+	}
+	ss.Rectangular = len(sec.Lines) > 1 && ss.MinWidth == ss.MaxWidth
+	if !ss.Rectangular {
+		return ss
+	}
+
+	var seen [256]bool
+	for _, line := range sec.Lines {
+		for i := range len(line) {
+			seen[line[i]] = true
+		}
+	}
+	for c, ok := range seen {
+		if ok {
+			ss.Alphabet = append(ss.Alphabet, byte(c))
+		}
+	}
+	ss.TilesPerByte = fastgraph.TilesPerByte(len(ss.Alphabet) + 1)
+	return ss
+}
+
+// counts sorts the values in m by count, most common first
+func counts[T cmp.Ordered](m map[T]int) []Count[T] {
+	c := make([]Count[T], 0, len(m))
+	for v, n := range m {
+		c = append(c, Count[T]{v, n})
+	}
+	slices.SortFunc(c, func(a, b Count[T]) int {
+		return cmp.Or(cmp.Compare(b.N, a.N), cmp.Compare(a.Value, b.Value))
+	})
+	return c
+}
+
+// Format writes the summary for a person to read. Only the most common chars and patterns are
+// listed.
+func (s Summary) Format(w io.Writer) {
+	fmt.Fprintf(w, "%d bytes, %d lines, width %s\n", s.Bytes, s.Lines, widths(s.MinWidth, s.MaxWidth))
+	if s.Normalization.Changed() {
+		fmt.Fprintf(w, "normalizing would have: %s\n", s.Normalization)
+	}
+
+	fmt.Fprintf(w, "\n%d sections\n", len(s.Sections))
+	for _, sec := range s.Sections {
+		fmt.Fprintf(w, "  line %d: %d lines, width %s", sec.Start, len(sec.Lines), widths(sec.MinWidth, sec.MaxWidth))
+		if sec.Rectangular {
+			fmt.Fprintf(w, ", rectangular grid of %d symbols %s", len(sec.Alphabet), strconv.Quote(string(sec.Alphabet)))
+			if sec.TilesPerByte > 0 {
+				fmt.Fprintf(w, ", fastgraph tilesPerByte %d", sec.TilesPerByte)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "\n%d distinct chars\n", len(s.Chars))
+	for _, c := range s.Chars[:min(len(s.Chars), 10)] {
+		fmt.Fprintf(w, "  %-6s %d\n", strconv.QuoteRune(rune(c.Value)), c.N)
+	}
+
+	if s.Ints.Count > 0 {
+		fits := "fits " + s.Ints.Fits()
+		if s.Ints.Fits() == "" {
+			fits = "overflows uint64, use math/big"
+		}
+		fmt.Fprintf(w, "\n%d integers (%d negative) from %s to %s, %s\n", s.Ints.Count, s.Ints.Negatives, s.Ints.Min, s.Ints.Max, fits)
+	}
+
+	fmt.Fprintf(w, "\n%d line patterns\n", len(s.Patterns))
+	for _, p := range s.Patterns[:min(len(s.Patterns), 5)] {
+		pattern := p.Value
+		if len(pattern) > 60 {
+			pattern = pattern[:57] + "..."
+		}
+		fmt.Fprintf(w, "  %6d  %s\n", p.N, pattern)
+	}
+}
+
+func widths(lo, hi int) string {
+	if lo == hi {
+		return strconv.Itoa(lo)
+	}
+	return fmt.Sprintf("%d-%d", lo, hi)
+}
@@ -0,0 +1,52 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect(t *testing.T) {
+	s := Inspect([]byte("..@@\r\n@.@.\r\n\r\nmove 3 from 1 to -2\r\nmove 10 from 12 to 4\r\n"))
+	require.Equal(t, 5, s.Lines)
+	require.Equal(t, 4, s.MinWidth)
+	require.Equal(t, 20, s.MaxWidth)
+	require.Equal(t, 5, s.Normalization.CRLF)
+
+	require.Len(t, s.Sections, 2)
+	require.True(t, s.Sections[0].Rectangular)
+	require.Equal(t, []byte(".@"), s.Sections[0].Alphabet)
+	require.Equal(t, 4, s.Sections[0].TilesPerByte, "'.', '@' and out-of-bounds need 2 bits each")
+	require.False(t, s.Sections[1].Rectangular)
+	require.Equal(t, 4, s.Sections[1].Start)
+
+	require.Equal(t, Count[byte]{' ', 10}, s.Chars[0])
+	require.Equal(t, 6, s.Ints.Count)
+	require.Equal(t, 1, s.Ints.Negatives)
+	require.Equal(t, "-2", s.Ints.Min.String())
+	require.Equal(t, "12", s.Ints.Max.String())
+	require.Equal(t, "int32", s.Ints.Fits())
+
+	require.Equal(t, Count[string]{"move {:int} from {:int} to {:int}", 2}, s.Patterns[0])
+	p, err := Compile(s.Patterns[0].Value)
+	require.NoError(t, err, "patterns should compile")
+	var n, from, to int
+	require.NoError(t, p.Scan("move 3 from 1 to -2", &n, &from, &to), "patterns should scan their own lines")
+	require.Equal(t, []int{3, 1, -2}, []int{n, from, to})
+}
+
+func TestIntSummaryFits(t *testing.T) {
+	for line, want := range map[string]string{
+		"2147483647 -2147483648":  "int32",
+		"2147483648":              "int64",
+		"-9223372036854775808":    "int64",
+		"18446744073709551615":    "uint64",
+		"18446744073709551616":    "",
+		"-1 18446744073709551615": "",
+	} {
+		var s IntSummary
+		s.add(line)
+		require.Equal(t, want, s.Fits(), line)
+	}
+	require.Equal(t, "{{x}} {:int}", new(IntSummary).add("{x} 1"))
+}
@@ -0,0 +1,423 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A Pattern matches a whole line against literal text and typed fields. Fields are written as
+// {name:type}, where type is one of
+//
+//	int   signed integer, e.g. -12
+//	u64   unsigned integer, e.g. 12
+//	word  a run of letters, digits and '_'
+//	str   any text up to the next literal in the pattern, or the end of the line (the default)
+//	A|B   exactly one of the alternatives, e.g. {dir:R|L}
+//
+// Literal braces are written as {{ and }}. Compile a pattern once and reuse it on every line.
+type Pattern struct {
+	src    string
+	elems  []element
+	fields []*element
+}
+
+type fieldKind int
+
+const (
+	literal fieldKind = iota
+	intField
+	uintField
+	wordField
+	strField
+	altField
+)
+
+type element struct {
+	kind fieldKind
+	name string
+	text string   // literal text
+	alts []string // alternatives, longest first so prefixes don't win
+}
+
+func (e *element) describe() string {
+	switch e.kind {
+	case literal:
+		return strconv.Quote(e.text)
+	case intField:
+		return "integer"
+	case uintField:
+		return "unsigned integer"
+	case wordField:
+		return "word"
+	case altField:
+		quoted := make([]string, len(e.alts))
+		for i, a := range e.alts {
+			quoted[i] = strconv.Quote(a)
+		}
+		return "one of " + strings.Join(quoted, ", ")
+	}
+	return "text"
+}
+
+// Compile parses a pattern, see Pattern for the syntax
+func Compile(pattern string) (*Pattern, error) {
+	p := &Pattern{src: pattern}
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			p.elems = append(p.elems, element{kind: literal, text: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case (c == '{' || c == '}') && i+1 < len(pattern) && pattern[i+1] == c:
+			lit.WriteByte(c)
+			i++
+		case c == '}':
+			return nil, fmt.Errorf("pattern %q: unmatched '}' at column %d", pattern, i+1)
+		case c == '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("pattern %q: unclosed '{' at column %d", pattern, i+1)
+			}
+			e, err := compileField(pattern[i+1 : i+end])
+			if err != nil {
+				return nil, fmt.Errorf("pattern %q: column %d: %w", pattern, i+1, err)
+			}
+			if n := len(p.elems); lit.Len() == 0 && n > 0 && p.elems[n-1].kind == strField {
+				return nil, fmt.Errorf("pattern %q: column %d: a str field must be followed by a literal", pattern, i+1)
+			}
+			flush()
+			p.elems = append(p.elems, e)
+			i += end
+		default:
+			lit.WriteByte(c)
+		}
+	}
+	flush()
+
+	for i := range p.elems {
+		if p.elems[i].kind != literal {
+			p.fields = append(p.fields, &p.elems[i])
+		}
+	}
+	return p, nil
+}
+
+func compileField(spec string) (element, error) {
+	name, typ, _ := strings.Cut(spec, ":")
+	e := element{name: strings.TrimSpace(name)}
+	switch typ = strings.TrimSpace(typ); typ {
+	case "int", "i64":
+		e.kind = intField
+	case "u64", "uint":
+		e.kind = uintField
+	case "word":
+		e.kind = wordField
+	case "", "str":
+		e.kind = strField
+	default:
+		if !strings.Contains(typ, "|") {
+			return element{}, fmt.Errorf("unknown field type %q", typ)
+		}
+		e.kind = altField
+		e.alts = strings.Split(typ, "|")
+		for _, a := range e.alts {
+			if a == "" {
+				return element{}, fmt.Errorf("empty alternative in %q", typ)
+			}
+		}
+		// try longer alternatives first so that e.g. "LL" isn't matched as "L"
+		slices.SortStableFunc(e.alts, func(a, b string) int { return len(b) - len(a) })
+	}
+	return e, nil
+}
+
+// MustCompile is Compile, panicking if the pattern is invalid. It's meant for package level vars.
+func MustCompile(pattern string) *Pattern {
+	p, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (p *Pattern) String() string {
+	return p.src
+}
+
+// MatchError is a line that doesn't match a Pattern
+type MatchError struct {
+	Column int    // 1-indexed byte column where matching failed
+	Span   int    // number of bytes at fault from Column
+	Field  string // field being matched, empty for literal text
+	Err    error
+}
+
+func (e *MatchError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("column %d: field %q: %v", e.Column, e.Field, e.Err)
+	}
+	return fmt.Sprintf("column %d: %v", e.Column, e.Err)
+}
+
+func (e *MatchError) Unwrap() error {
+	return e.Err
+}
+
+// value is a matched field, only one of i, u or s is set depending on the field kind
+type value struct {
+	i int64
+	u uint64
+	s string
+}
+
+// match walks the line, storing the value of every field in order in values
+func (p *Pattern) match(line string, values []value) error {
+	pos := 0
+	fail := func(e *element, span int, err error) error {
+		return &MatchError{Column: pos + 1, Span: span, Field: e.name, Err: err}
+	}
+	expected := func(e *element) error {
+		got := "end of line"
+		if pos < len(line) {
+			got = strconv.Quote(line[pos:min(pos+10, len(line))])
+		}
+		return fail(e, 1, fmt.Errorf("expected %s, found %s", e.describe(), got))
+	}
+
+	f := 0
+	for i := range p.elems {
+		e := &p.elems[i]
+		switch e.kind {
+		case literal:
+			if !strings.HasPrefix(line[pos:], e.text) {
+				return expected(e)
+			}
+			pos += len(e.text)
+			continue
+		case intField, uintField:
+			end := pos
+			if e.kind == intField && end < len(line) && (line[end] == '-' || line[end] == '+') {
+				end++
+			}
+			digits := end
+			for end < len(line) && isDigit(line[end]) {
+				end++
+			}
+			if end == digits {
+				return expected(e)
+			}
+			var err error
+			if e.kind == intField {
+				values[f].i, err = strconv.ParseInt(line[pos:end], 10, 64)
+			} else {
+				values[f].u, err = strconv.ParseUint(line[pos:end], 10, 64)
+			}
+			if err != nil {
+				return fail(e, end-pos, err)
+			}
+			pos = end
+		case wordField:
+			end := pos
+			for end < len(line) && (line[end] == '_' || isDigit(line[end]) || unicode.IsLetter(rune(line[end]))) {
+				end++
+			}
+			if end == pos {
+				return expected(e)
+			}
+			values[f].s = line[pos:end]
+			pos = end
+		case altField:
+			matched := false
+			for _, a := range e.alts {
+				if strings.HasPrefix(line[pos:], a) {
+					values[f].s = a
+					pos += len(a)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return expected(e)
+			}
+		case strField:
+			end := len(line)
+			if i+1 < len(p.elems) {
+				// Compile guarantees the next element is a literal
+				next := strings.Index(line[pos:], p.elems[i+1].text)
+				if next < 0 {
+					pos = len(line)
+					return expected(&p.elems[i+1])
+				}
+				end = pos + next
+			}
+			values[f].s = line[pos:end]
+			pos = end
+		}
+		f++
+	}
+	if pos != len(line) {
+		return &MatchError{Column: pos + 1, Span: len(line) - pos, Err: fmt.Errorf("unexpected %q after end of pattern", line[pos:])}
+	}
+	return nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// Scan matches line against the pattern and stores the fields in dst. dst is either a single
+// pointer to a struct, whose fields are filled by name (case-insensitive, or a `parse:"name"`
+// tag), or one pointer per field in the order the fields appear in the pattern.
+func (p *Pattern) Scan(line string, dst ...any) error {
+	var buf [8]value
+	values := buf[:0]
+	if len(p.fields) > len(buf) {
+		values = make([]value, 0, len(p.fields))
+	}
+	values = values[:len(p.fields)]
+	if err := p.match(line, values); err != nil {
+		return err
+	}
+
+	if len(dst) == 1 {
+		if v := reflect.ValueOf(dst[0]); v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Struct {
+			return p.scanStruct(v.Elem(), values)
+		}
+	}
+	if len(dst) != len(p.fields) {
+		return fmt.Errorf("pattern %q has %d fields, got %d destinations", p.src, len(p.fields), len(dst))
+	}
+	for i, d := range dst {
+		v := reflect.ValueOf(d)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			return fmt.Errorf("destination %d is not a non-nil pointer", i)
+		}
+		if err := p.fields[i].assign(v.Elem(), values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Pattern) scanStruct(s reflect.Value, values []value) error {
+	t := s.Type()
+	for i, e := range p.fields {
+		if e.name == "" {
+			continue
+		}
+		found := false
+		for j := range t.NumField() {
+			sf := t.Field(j)
+			if !sf.IsExported() {
+				continue
+			}
+			tag := sf.Tag.Get("parse")
+			if tag == e.name || (tag == "" && strings.EqualFold(sf.Name, e.name)) {
+				if err := e.assign(s.Field(j), values[i]); err != nil {
+					return err
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("pattern %q: %s has no field for %q", p.src, t, e.name)
+		}
+	}
+	return nil
+}
+
+var errOverflow = errors.New("value out of range")
+
+func (e *element) assign(dst reflect.Value, v value) error {
+	fail := func(err error) error {
+		return fmt.Errorf("field %q: cannot store %s in %s: %w", e.name, e.describe(), dst.Type(), err)
+	}
+	switch e.kind {
+	case intField:
+		switch dst.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if dst.OverflowInt(v.i) {
+				return fail(errOverflow)
+			}
+			dst.SetInt(v.i)
+			return nil
+		}
+	case uintField:
+		switch dst.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if dst.OverflowUint(v.u) {
+				return fail(errOverflow)
+			}
+			dst.SetUint(v.u)
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v.u > 1<<63-1 || dst.OverflowInt(int64(v.u)) {
+				return fail(errOverflow)
+			}
+			dst.SetInt(int64(v.u))
+			return nil
+		}
+	default:
+		if dst.Kind() == reflect.String {
+			dst.SetString(v.s)
+			return nil
+		}
+	}
+	return fail(errors.New("unsupported destination type"))
+}
+
+// ExtractInts returns every signed integer in line, in order. A '-' is only treated as a sign if
+// it isn't directly after a digit, so "3-5" is 3 and 5. An integer too big for an int saturates
+// to math.MaxInt or math.MinInt rather than wrapping; use a Pattern to have it reported.
+func ExtractInts(line string) []int {
+	return AppendInts(nil, line)
+}
+
+// AppendInts is ExtractInts appending to dst, so a buffer can be reused across lines
+func AppendInts(dst []int, line string) []int {
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		neg := false
+		if c == '-' && i+1 < len(line) && isDigit(line[i+1]) && (i == 0 || !isDigit(line[i-1])) {
+			neg = true
+			i++
+			c = line[i]
+		}
+		if !isDigit(c) {
+			continue
+		}
+		// accumulate the magnitude, which can be one more than math.MaxInt for a negative number
+		limit := uint64(math.MaxInt)
+		if neg {
+			limit++
+		}
+		var u uint64
+		for ; i < len(line) && isDigit(line[i]); i++ {
+			d := uint64(line[i] - '0')
+			if u > (limit-d)/10 {
+				u = limit
+				continue
+			}
+			u = u*10 + d
+		}
+		n := int(u)
+		if neg {
+			n = int(-u)
+		}
+		dst = append(dst, n)
+		i-- // the loop increment moves past the last digit
+	}
+	return dst
+}
@@ -0,0 +1,92 @@
+package parse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternScanStruct(t *testing.T) {
+	p := MustCompile("{dir:R|L}{n:int}")
+
+	var rotation struct {
+		Dir string
+		N   int
+	}
+	require.NoError(t, p.Scan("L68", &rotation))
+	require.Equal(t, "L", rotation.Dir)
+	require.Equal(t, 68, rotation.N)
+
+	var tagged struct {
+		Direction string `parse:"dir"`
+		Clicks    int8   `parse:"n"`
+	}
+	require.NoError(t, p.Scan("R5", &tagged))
+	require.Equal(t, "R", tagged.Direction)
+	require.EqualValues(t, 5, tagged.Clicks)
+
+	require.ErrorContains(t, p.Scan("R500", &tagged), "out of range")
+}
+
+func TestPatternScanTuple(t *testing.T) {
+	p := MustCompile("{lo:u64}-{hi:u64}")
+
+	var lo, hi uint64
+	require.NoError(t, p.Scan("1188511880-1188511890", &lo, &hi))
+	require.EqualValues(t, 1188511880, lo)
+	require.EqualValues(t, 1188511890, hi)
+
+	require.Error(t, p.Scan("1-2", &lo))
+}
+
+func TestPatternStrAndWord(t *testing.T) {
+	p := MustCompile("{name:word} -> {targets}. {{ok}}")
+
+	var name, targets string
+	require.NoError(t, p.Scan("abc_1 -> x, y. {ok}", &name, &targets))
+	require.Equal(t, "abc_1", name)
+	require.Equal(t, "x, y", targets)
+}
+
+func TestPatternMismatch(t *testing.T) {
+	p := MustCompile("{dir:R|L}{n:int}")
+
+	var dir string
+	var n int
+
+	err := p.Scan("X12", &dir, &n)
+	var merr *MatchError
+	require.ErrorAs(t, err, &merr)
+	require.Equal(t, 1, merr.Column)
+	require.Equal(t, "dir", merr.Field)
+
+	err = p.Scan("Rx", &dir, &n)
+	require.ErrorAs(t, err, &merr)
+	require.Equal(t, 2, merr.Column)
+	require.ErrorContains(t, err, "expected integer")
+
+	err = p.Scan("R12 ", &dir, &n)
+	require.ErrorAs(t, err, &merr)
+	require.Equal(t, 4, merr.Column)
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, pattern := range []string{"{a", "a}", "{a:float}", "{a:R|}", "{a}{b}"} {
+		_, err := Compile(pattern)
+		require.Error(t, err, pattern)
+	}
+}
+
+func TestExtractInts(t *testing.T) {
+	require.Equal(t, []int{3, 5, -2, 10, -7}, ExtractInts("3-5 x=-2, y=10..-7"))
+	require.Empty(t, ExtractInts("no numbers - here"))
+
+	// too big for an int
+	require.Equal(t, []int{math.MaxInt, math.MinInt, math.MaxInt, math.MinInt},
+		ExtractInts("9223372036854775807 -9223372036854775808 9223372036854775808 -99999999999999999999999"))
+
+	buf := make([]int, 0, 4)
+	buf = AppendInts(buf[:0], "1,2")
+	require.Equal(t, []int{1, 2}, buf)
+}
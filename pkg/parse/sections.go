@@ -0,0 +1,196 @@
+// Package parse turns puzzle input into typed values, reporting where in the input anything
+// went wrong.
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+)
+
+// Section is a run of non-blank lines, separated from other sections by one or more blank lines
+type Section struct {
+	Index int // 0-indexed position of the section in the input
+	Start int // 1-indexed line number of the section's first line in the whole input
+	Lines []string
+}
+
+// Sections splits lines into sections at blank lines
+func Sections(lines []string) []Section {
+	var sections []Section
+	var current *Section
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			sections = append(sections, Section{Index: len(sections), Start: i + 1})
+			current = &sections[len(sections)-1]
+		}
+		current.Lines = append(current.Lines, line)
+	}
+	return sections
+}
+
+// ReadSections reads r with utils.ReadLinesMode and splits it into sections
+func ReadSections(r io.Reader, mode utils.LineMode) ([]Section, error) {
+	lines, err := utils.ReadLinesMode(r, mode)
+	if err != nil {
+		return nil, err
+	}
+	return Sections(lines), nil
+}
+
+// ExpectSections returns an error unless there are exactly n sections
+func ExpectSections(sections []Section, n int) error {
+	if len(sections) != n {
+		return fmt.Errorf("expected %d sections separated by blank lines, found %d", n, len(sections))
+	}
+	return nil
+}
+
+// add records an error against the line at index i of the section, see Diagnostics.Add
+func (s Section) add(d *Diagnostics, i, column int, err error) {
+	d.Add(s.Start+i, column, s.Lines[i], err)
+	(*d)[len(*d)-1].Section = s.Index + 1
+}
+
+// Range is an inclusive range of non-negative integers
+type Range struct {
+	Lo uint64
+	Hi uint64
+}
+
+// Ranges parses ranges written as "lo-hi". A line may hold several ranges separated by commas,
+// e.g. "11-22,95-115". Every bad range is reported in the returned Diagnostics.
+func (s Section) Ranges() ([]Range, error) {
+	var diags Diagnostics
+	var ranges []Range
+	for i, line := range s.Lines {
+		offset := 0
+		for field := range strings.SplitSeq(line, ",") {
+			column := offset + 1
+			offset += len(field) + 1
+			trimmed := strings.TrimLeft(field, " ")
+			column += len(field) - len(trimmed)
+			if field = strings.TrimRight(trimmed, " "); field == "" {
+				continue
+			}
+			if !strings.Contains(field, "-") {
+				s.add(&diags, i, column, &MatchError{Column: 1, Span: len(field), Err: fmt.Errorf("range %q is not of the form lo-hi", field)})
+				continue
+			}
+			var r Range
+			if err := rangePattern.Scan(field, &r.Lo, &r.Hi); err != nil {
+				s.add(&diags, i, column, err)
+				continue
+			}
+			ranges = append(ranges, r)
+		}
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+var rangePattern = MustCompile("{lo:u64}-{hi:u64}")
+
+// List splits every line on sep, trimming each item and dropping empty ones
+func (s Section) List(sep string) []string {
+	var items []string
+	for _, line := range s.Lines {
+		for item := range strings.SplitSeq(line, sep) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// Ints parses a single signed integer per line
+func (s Section) Ints() ([]int, error) {
+	var diags Diagnostics
+	result := make([]int, len(s.Lines))
+	for i, line := range s.Lines {
+		x, err := strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			s.add(&diags, i, 0, err)
+		}
+		result[i] = x
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Uints parses a single unsigned 64-bit integer per line
+func (s Section) Uints() ([]uint64, error) {
+	var diags Diagnostics
+	result := make([]uint64, len(s.Lines))
+	for i, line := range s.Lines {
+		x, err := strconv.ParseUint(strings.TrimSpace(line), 10, 64)
+		if err != nil {
+			s.add(&diags, i, 0, err)
+		}
+		result[i] = x
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Grid checks the section is rectangular and returns its rows, ready for slowgraph.NewGraph or
+// fastgraph.LinesToGrid
+func (s Section) Grid() ([]string, error) {
+	var diags Diagnostics
+	for i, line := range s.Lines {
+		if len(line) != len(s.Lines[0]) {
+			s.add(&diags, i, 0, fmt.Errorf("row is %d wide, expected %d", len(line), len(s.Lines[0])))
+		}
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return s.Lines, nil
+}
+
+// KeyValues is a line of the form "key: value value ..."
+type KeyValues struct {
+	Key    string
+	Values []string
+}
+
+// KeyValues parses "key: values" lines, splitting the values on commas and/or whitespace
+func (s Section) KeyValues() ([]KeyValues, error) {
+	var diags Diagnostics
+	result := make([]KeyValues, len(s.Lines))
+	for i, line := range s.Lines {
+		key, values, ok := strings.Cut(line, ":")
+		if !ok {
+			s.add(&diags, i, 0, errors.New("missing ':' after key"))
+			continue
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			s.add(&diags, i, 1, errors.New("empty key"))
+			continue
+		}
+		result[i] = KeyValues{
+			Key:    key,
+			Values: strings.FieldsFunc(values, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }),
+		}
+	}
+	if err := diags.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package parse
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/josiemessa/aoc2025/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSections(t *testing.T) {
+	sections, err := ReadSections(strings.NewReader("3-5\n10-14\n\n\n1\n5\n"), utils.Trim)
+	require.NoError(t, err)
+	require.NoError(t, ExpectSections(sections, 2))
+
+	require.Equal(t, Section{Index: 0, Start: 1, Lines: []string{"3-5", "10-14"}}, sections[0])
+	require.Equal(t, Section{Index: 1, Start: 5, Lines: []string{"1", "5"}}, sections[1])
+
+	require.Error(t, ExpectSections(sections, 3))
+}
+
+func TestRanges(t *testing.T) {
+	ranges, err := Section{Lines: []string{"11-22,95-115", "998-1012"}}.Ranges()
+	require.NoError(t, err)
+	require.Equal(t, []Range{{11, 22}, {95, 115}, {998, 1012}}, ranges)
+
+	_, err = Section{Index: 1, Start: 4, Lines: []string{"1-2", "3-x"}}.Ranges()
+	var perr *Error
+	require.ErrorAs(t, err, &perr)
+	require.Equal(t, 2, perr.Section)
+	require.Equal(t, 5, perr.Line)
+	require.Equal(t, 3, perr.Column)
+
+	_, err = Section{Lines: []string{"12"}}.Ranges()
+	require.ErrorContains(t, err, "not of the form lo-hi")
+}
+
+func TestIntsAndUints(t *testing.T) {
+	ints, err := Section{Lines: []string{"1", " -5"}}.Ints()
+	require.NoError(t, err)
+	require.Equal(t, []int{1, -5}, ints)
+
+	uints, err := Section{Lines: []string{"18446744073709551615"}}.Uints()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{18446744073709551615}, uints)
+
+	_, err = Section{Lines: []string{"-1"}}.Uints()
+	require.Error(t, err)
+}
+
+func TestGrid(t *testing.T) {
+	grid, err := Section{Lines: []string{"..@", "@.."}}.Grid()
+	require.NoError(t, err)
+	require.Len(t, grid, 2)
+
+	_, err = Section{Start: 1, Lines: []string{"..@", "@."}}.Grid()
+	var perr *Error
+	require.True(t, errors.As(err, &perr))
+	require.Equal(t, 2, perr.Line)
+}
+
+func TestKeyValues(t *testing.T) {
+	kvs, err := Section{Lines: []string{"Starting items: 79, 98", "Test:divisible"}}.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValues{
+		{Key: "Starting items", Values: []string{"79", "98"}},
+		{Key: "Test", Values: []string{"divisible"}},
+	}, kvs)
+
+	_, err = Section{Lines: []string{"no colon"}}.KeyValues()
+	require.ErrorContains(t, err, "missing ':'")
+}
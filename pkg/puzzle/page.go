@@ -0,0 +1,112 @@
+// Package puzzle reads the examples out of a saved puzzle page, so they don't have to be copied
+// into test-input files by hand. The page's structure is matched with regular expressions rather
+// than a full HTML parser, as the site's markup is simple and consistent.
+package puzzle
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Article is the description of one part of the puzzle
+type Article struct {
+	Part int
+	// Blocks are the text of the article's <pre><code> blocks in order
+	Blocks []string
+	// Answer is the last emphasized code in the article, which is usually the example's answer
+	Answer string
+	// NewExample is the index in Blocks of an example introduced by this part, or -1 if the part
+	// reuses an earlier example
+	NewExample int
+}
+
+// Page is a saved puzzle page
+type Page struct {
+	Articles []Article
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	blockPattern   = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	answerPattern  = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>|<em><code>(.*?)</code></em>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	// examplePattern is how the text introducing an example input usually reads, and
+	// reusePattern how it reads when going back to an earlier example to illustrate it
+	examplePattern = regexp.MustCompile(`(?i)\bexample\b`)
+	reusePattern   = regexp.MustCompile(`(?i)\b(same|above|earlier|previous) example\b|\bexample (above|from before)\b`)
+)
+
+// ParsePage finds each part's article in a saved puzzle page
+func ParsePage(page string) Page {
+	var p Page
+	for i, m := range articlePattern.FindAllStringSubmatch(page, -1) {
+		p.Articles = append(p.Articles, parseArticle(i+1, m[1]))
+	}
+	return p
+}
+
+func parseArticle(part int, body string) Article {
+	a := Article{Part: part, NewExample: -1}
+
+	answers := answerPattern.FindAllStringSubmatchIndex(body, -1)
+	var answerAt int
+	if len(answers) > 0 {
+		m := answers[len(answers)-1]
+		answerAt = m[0]
+		if m[2] >= 0 {
+			a.Answer = text(body[m[2]:m[3]])
+		} else {
+			a.Answer = text(body[m[4]:m[5]])
+		}
+	}
+
+	prev := 0
+	for i, m := range blockPattern.FindAllStringSubmatchIndex(body, -1) {
+		a.Blocks = append(a.Blocks, text(body[m[2]:m[3]]))
+		// the first block that's introduced as an example and comes before the answer is taken
+		// to be the input the answer is for
+		intro := body[prev:m[0]]
+		if a.NewExample < 0 && examplePattern.MatchString(intro) && !reusePattern.MatchString(intro) &&
+			(len(answers) == 0 || m[0] < answerAt) {
+			a.NewExample = i
+		}
+		prev = m[1]
+	}
+	return a
+}
+
+// text is the plain text of a fragment of HTML
+func text(fragment string) string {
+	return html.UnescapeString(tagPattern.ReplaceAllString(fragment, ""))
+}
+
+// Example is an example input and the answers given for it
+type Example struct {
+	Input   string
+	Answers [2]string
+}
+
+// Examples guesses the example inputs on the page and their answers. Part 1's example is the
+// first block introduced as one, or failing that its first block. Part 2 adds a second example
+// if it introduces a block that differs from part 1's, otherwise its answer is for part 1's.
+func (p Page) Examples() []Example {
+	var examples []Example
+	for _, a := range p.Articles {
+		if a.Part < 1 || a.Part > 2 {
+			continue
+		}
+		idx := a.NewExample
+		if a.Part == 1 && idx < 0 && len(a.Blocks) > 0 {
+			idx = 0
+		}
+
+		if idx >= 0 && (len(examples) == 0 || a.Blocks[idx] != examples[0].Input) {
+			examples = append(examples, Example{Input: a.Blocks[idx]})
+		}
+		if len(examples) > 0 {
+			examples[len(examples)-1].Answers[a.Part-1] = strings.TrimSpace(a.Answer)
+		}
+	}
+	return examples
+}
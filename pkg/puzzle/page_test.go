@@ -0,0 +1,71 @@
+package puzzle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readPage(t *testing.T, name string) Page {
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return ParsePage(string(b))
+}
+
+func TestExamples(t *testing.T) {
+	for _, tc := range []struct {
+		page string
+		want []Example
+	}{
+		{
+			// part 2 reuses part 1's example
+			page: "day1.html",
+			want: []Example{{
+				Input:   "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n",
+				Answers: [2]string{"3", "6"},
+			}},
+		},
+		{
+			// the blocks after the example and in part 2 are illustrations of it
+			page: "day4.html",
+			want: []Example{{
+				Input:   "..@@.@@@@.\n@@@.@.@.@@\n@@@@@.@.@@\n@.@@@@..@.\n@@.@@@@.@@\n.@@@@@@@.@\n.@.@.@.@@@\n@.@@@.@@@@\n.@@@@@@@@.\n@.@.@@@.@.\n",
+				Answers: [2]string{"13", "43"},
+			}},
+		},
+		{
+			// part 2 has its own example, and the blocks have emphasis and escapes
+			page: "new-example.html",
+			want: []Example{
+				{Input: "xmul(2,4)%&mul[3,7]!@^do_not_mul(5,5)+mul(32,64]then(mul(11,8)mul(8,5))", Answers: [2]string{"161", ""}},
+				{Input: "xmul(2,4)&mul[3,7]!^don't()_mul(5,5)+mul(32,64](mul(11,8)undo()?mul(8,5))", Answers: [2]string{"", "48"}},
+			},
+		},
+		{
+			// part 2 isn't unlocked until part 1 is solved
+			page: "part1-only.html",
+			want: []Example{{
+				Input:   "3-5\n10-14\n16-20\n12-18\n\n1\n5\n8\n11\n17\n32\n",
+				Answers: [2]string{"3", ""},
+			}},
+		},
+	} {
+		t.Run(tc.page, func(t *testing.T) {
+			require.Equal(t, tc.want, readPage(t, tc.page).Examples())
+		})
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	page := readPage(t, "day4.html")
+	require.Len(t, page.Articles, 2)
+	require.Equal(t, 1, page.Articles[0].Part)
+	require.Len(t, page.Articles[0].Blocks, 2)
+	require.Equal(t, 0, page.Articles[0].NewExample)
+	require.Equal(t, "13", page.Articles[0].Answer)
+	require.Equal(t, -1, page.Articles[1].NewExample)
+
+	require.Empty(t, ParsePage("<html><body>Not found</body></html>").Examples())
+}
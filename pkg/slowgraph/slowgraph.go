@@ -2,9 +2,11 @@ package slowgraph
 
 import (
 	"container/heap"
+	"context"
 	"math"
 	"slices"
 
+	"github.com/josiemessa/aoc2025/pkg/logging"
 	"github.com/josiemessa/aoc2025/pkg/queue"
 )
 
@@ -126,11 +128,21 @@ func (g *Chess) Distance(a Coord, b Coord) uint {
 
 // FloodFill finds every tile in the graph and executes f() on that
 func (g *GridGraph) FloodFill(start Coord, f func(current Coord, neighbours []Coord)) {
+	g.FloodFillContext(context.Background(), start, f)
+}
+
+// FloodFillContext is FloodFill, stopping early with ctx.Err() once ctx is done. It logs to the
+// logger carried by ctx.
+func (g *GridGraph) FloodFillContext(ctx context.Context, start Coord, f func(current Coord, neighbours []Coord)) error {
+	logger := logging.Component(logging.FromContext(ctx), "slowgraph")
 	frontier := make(queue.Queue, 0)
 	frontier.Enqueue(&queue.Item{Value: start})
 	reached := map[Coord]struct{}{start: {}}
 
 	for len(frontier) != 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		current := frontier.Dequeue().Value.(Coord)
 		// TODO: this assumes chess neighbours
 		neighbours := g.Mover.Neighbours(current, g.NumCols, g.NumRows)
@@ -140,8 +152,13 @@ func (g *GridGraph) FloodFill(start Coord, f func(current Coord, neighbours []Co
 				reached[next] = struct{}{}
 			}
 		}
+		if logger.Enabled(ctx, logging.LevelTrace) {
+			logging.Trace(logger, "flood fill visit", "x", current.X, "y", current.Y, "frontier", len(frontier))
+		}
 		f(current, neighbours)
 	}
+	logger.Debug("flood fill done", "x", start.X, "y", start.Y, "reached", len(reached))
+	return nil
 }
 
 // BreadthFirstSearch generates a map of which tile we came from to reach the current tile, starting at start.
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/josiemessa/aoc2025/pkg/config"
+)
+
+// EncryptedSuffix is added to an input's file name for the encrypted copy committed to the
+// repo, e.g. day4/input.enc
+const EncryptedSuffix = ".enc"
+
+// KeySize is the length of an input key, for AES-256
+const KeySize = 32
+
+// ErrNoInputKey is returned when reading an encrypted input without a key to decrypt it
+var ErrNoInputKey = errors.New("input is encrypted and there's no key, set AOC_INPUT_KEY or input_key in the config file")
+
+// encryptedMagic starts every encrypted input, so the format can be changed later
+var encryptedMagic = []byte("aoc2025-input-v1\n")
+
+// InputKey returns the key for encrypted inputs from the config
+func InputKey() ([]byte, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.InputKey == "" {
+		return nil, ErrNoInputKey
+	}
+	key, err := base64.StdEncoding.DecodeString(cfg.InputKey)
+	if err != nil {
+		return nil, fmt.Errorf("input key: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("input key is %d bytes, want %d", len(key), KeySize)
+	}
+	return key, nil
+}
+
+// NewInputKey returns a random key, base64 encoded as it's stored in the config
+func NewInputKey() string {
+	key := make([]byte, KeySize)
+	rand.Read(key)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// Encrypt seals plaintext with key using AES-GCM. The nonce is derived from the plaintext, so
+// the same input always encrypts to the same bytes and re-encrypting an unchanged input doesn't
+// show up as a change in git. A nonce is only ever reused for the same plaintext, which reveals
+// nothing beyond that the input hasn't changed.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	_, nonceKey := subkeys(key)
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write(plaintext)
+	nonce := mac.Sum(nil)[:aead.NonceSize()]
+
+	out := append(bytes.Clone(encryptedMagic), nonce...)
+	return aead.Seal(out, nonce, plaintext, encryptedMagic), nil
+}
+
+// Decrypt opens ciphertext made by Encrypt
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	rest, ok := bytes.CutPrefix(ciphertext, encryptedMagic)
+	if !ok || len(rest) < aead.NonceSize() {
+		return nil, errors.New("not an encrypted input")
+	}
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], encryptedMagic)
+	if err != nil {
+		return nil, errors.New("could not decrypt input, the key is wrong or the file is corrupt")
+	}
+	return plaintext, nil
+}
+
+// subkeys derives separate keys for AES-GCM and for deriving nonces from key, so neither use of
+// the key can leak anything about the other
+func subkeys(key []byte) (encKey, nonceKey []byte) {
+	derive := func(label string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(label))
+		return mac.Sum(nil)
+	}
+	return derive("aoc2025-input encryption"), derive("aoc2025-input nonce")
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("input key is %d bytes, want %d", len(key), KeySize)
+	}
+	encKey, _ := subkeys(key)
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ReadInput reads the input at path, or decrypts its encrypted copy if only that exists. An
+// empty file at path counts as missing, see InputExists. The error wraps ErrNoInputKey if the
+// input is only available encrypted and there's no key.
+func ReadInput(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if len(b) > 0 || err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return b, err
+	}
+	enc, encErr := os.ReadFile(path + EncryptedSuffix)
+	if errors.Is(encErr, fs.ErrNotExist) {
+		return b, err
+	}
+	if encErr != nil {
+		return nil, encErr
+	}
+
+	key, err := InputKey()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path+EncryptedSuffix, err)
+	}
+	b, err = Decrypt(key, enc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path+EncryptedSuffix, err)
+	}
+	return b, nil
+}
+
+// InputExists reports whether the input at path, or its encrypted copy, exists. An empty file
+// doesn't count: it's a placeholder, like the test-input aoc new leaves to be filled in.
+func InputExists(path string) (bool, error) {
+	for _, p := range []string{path, path + EncryptedSuffix} {
+		info, err := os.Stat(p)
+		if err == nil && info.Size() > 0 {
+			return true, nil
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+	}
+	return false, nil
+}
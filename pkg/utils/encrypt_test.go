@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt(t *testing.T) {
+	key := make([]byte, KeySize)
+	input := []byte("L68\nL30\n")
+
+	enc, err := Encrypt(key, input)
+	require.NoError(t, err)
+	require.NotContains(t, string(enc), "L68")
+	again, err := Encrypt(key, input)
+	require.NoError(t, err)
+	require.Equal(t, enc, again, "an unchanged input should encrypt the same")
+
+	plain, err := Decrypt(key, enc)
+	require.NoError(t, err)
+	require.Equal(t, input, plain)
+
+	other := make([]byte, KeySize)
+	other[0] = 1
+	_, err = Decrypt(other, enc)
+	require.ErrorContains(t, err, "key is wrong")
+	_, err = Decrypt(key, input)
+	require.ErrorContains(t, err, "not an encrypted input")
+}
+
+func TestSubkeys(t *testing.T) {
+	key := make([]byte, KeySize)
+	encKey, nonceKey := subkeys(key)
+	require.Len(t, encKey, KeySize)
+	require.Len(t, nonceKey, KeySize)
+	require.NotEqual(t, encKey, nonceKey)
+	require.NotEqual(t, key, encKey)
+	require.NotEqual(t, key, nonceKey)
+}
+
+func TestReadInput(t *testing.T) {
+	t.Setenv("AOC_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+	key := NewInputKey()
+	raw, err := base64.StdEncoding.DecodeString(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), InputFile)
+	enc, err := Encrypt(raw, []byte("secret\n"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path+EncryptedSuffix, enc, 0o644))
+
+	t.Setenv("AOC_INPUT_KEY", "")
+	_, err = ReadInput(path)
+	require.ErrorIs(t, err, ErrNoInputKey)
+
+	t.Setenv("AOC_INPUT_KEY", key)
+	b, err := ReadInput(path)
+	require.NoError(t, err)
+	require.Equal(t, "secret\n", string(b))
+
+	// an empty placeholder doesn't hide the encrypted copy
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+	b, err = ReadInput(path)
+	require.NoError(t, err)
+	require.Equal(t, "secret\n", string(b))
+	ok, err := InputExists(path)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// a plain copy is read as is
+	require.NoError(t, os.WriteFile(path, []byte("plain\n"), 0o644))
+	b, err = ReadInput(path)
+	require.NoError(t, err)
+	require.Equal(t, "plain\n", string(b))
+
+	_, err = ReadInput(filepath.Join(filepath.Dir(path), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestOpenInputError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	// a file can't be a directory, which isn't a not-exist error
+	rc, err := OpenInput(1, filepath.Join(file, InputFile), false)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, os.ErrNotExist)
+	require.True(t, rc == nil, "the reader should be a nil interface, not a nil *os.File")
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// Stdin is the input path that reads the puzzle input from standard input
+	Stdin = "-"
+
+	InputFile   = "input"
+	ExampleFile = "test-input"
+)
+
+// RepoRoot walks up from the working directory until it finds the directory containing go.mod,
+// so days can find their inputs whether run from the repo root or from inside dayN/
+func RepoRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("could not find repo root (no go.mod in any parent directory)")
+		}
+		dir = parent
+	}
+}
+
+// DayDir returns the path of the directory holding a day's solution and inputs
+func DayDir(day int) (string, error) {
+	root, err := RepoRoot()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, fmt.Sprintf("day%d", day)), nil
+}
+
+// InputPath resolves which file to read a day's input from. An explicit path wins (and may be
+// Stdin), otherwise it is the day's test-input if example is set, or the day's input.
+func InputPath(day int, path string, example bool) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	dir, err := DayDir(day)
+	if err != nil {
+		return "", err
+	}
+	if example {
+		return filepath.Join(dir, ExampleFile), nil
+	}
+	return filepath.Join(dir, InputFile), nil
+}
+
+// OpenInput opens the input chosen by InputPath, decrypting it if only its encrypted copy is
+// there or the input is an empty placeholder (see ReadInput). The caller must close it.
+func OpenInput(day int, path string, example bool) (io.ReadCloser, error) {
+	path, err := InputPath(day, path, example)
+	if err != nil {
+		return nil, err
+	}
+	if path == Stdin {
+		return io.NopCloser(os.Stdin), nil
+	}
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	b, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+)
+
+const (
+	// DefaultMaxLine is the longest line ReadLines accepts, the same as bufio.Scanner's default
+	DefaultMaxLine = bufio.MaxScanTokenSize
+	// LongLine is a max line length for inputs that are one huge line, e.g. day2's comma separated
+	// id ranges. The buffer only grows this big if a line needs it.
+	LongLine = 1 << 30
+)
+
+// LineReader streams lines from a reader one at a time through a single reused buffer, so
+// inputs never need to be held in memory all at once
+type LineReader struct {
+	scanner *bufio.Scanner
+	mode    LineMode
+	line    int
+}
+
+// NewLineReader reads lines from r, handling whitespace according to mode. maxLine is the longest
+// line it will read before failing with bufio.ErrTooLong, see DefaultMaxLine and LongLine.
+func NewLineReader(r io.Reader, mode LineMode, maxLine int) *LineReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(4096, maxLine)), maxLine)
+	if mode == Raw {
+		scanner.Split(scanRawLines)
+	}
+	return &LineReader{scanner: scanner, mode: mode}
+}
+
+// Bytes iterates over the 1-indexed line number and contents of each line. The slice is only
+// valid until the next iteration, as the buffer is reused; copy it to keep it.
+func (lr *LineReader) Bytes() iter.Seq2[int, []byte] {
+	return func(yield func(int, []byte) bool) {
+		for lr.scanner.Scan() {
+			lr.line++
+			line := lr.scanner.Bytes()
+			switch lr.mode {
+			case Trim:
+				line = bytes.TrimSpace(line)
+			case TrimRight:
+				line = bytes.TrimRight(line, " \t\r\v\f")
+			}
+			if !yield(lr.line, line) {
+				return
+			}
+		}
+	}
+}
+
+// Lines iterates over the 1-indexed line number and contents of each line
+func (lr *LineReader) Lines() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for n, line := range lr.Bytes() {
+			if !yield(n, string(line)) {
+				return
+			}
+		}
+	}
+}
+
+// Err returns the first error hit while reading, once iteration has stopped
+func (lr *LineReader) Err() error {
+	if err := lr.scanner.Err(); err != nil {
+		// the scanner failed on the line after the last one it returned
+		return fmt.Errorf("line %d: %w", lr.line+1, err)
+	}
+	return nil
+}
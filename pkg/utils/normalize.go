@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LineMode controls what happens to the whitespace around each line when reading lines
+type LineMode int
+
+const (
+	// Trim removes leading and trailing whitespace, including any '\r'. This is what ReadLines does.
+	Trim LineMode = iota
+	// TrimRight removes trailing whitespace only, for column-aligned inputs where indentation matters
+	TrimRight
+	// Raw returns each line exactly as it appears, only splitting on '\n' (so '\r' is kept)
+	Raw
+)
+
+func (m LineMode) String() string {
+	switch m {
+	case Trim:
+		return "trim"
+	case TrimRight:
+		return "trim-right"
+	case Raw:
+		return "raw"
+	}
+	return fmt.Sprintf("LineMode(%d)", int(m))
+}
+
+// ReadLinesMode reads every line from r, handling whitespace according to mode
+func ReadLinesMode(r io.Reader, mode LineMode) ([]string, error) {
+	lr := NewLineReader(r, mode, DefaultMaxLine)
+	var lines []string
+	for _, line := range lr.Lines() {
+		lines = append(lines, line)
+	}
+	if err := lr.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// scanRawLines is bufio.ScanLines without dropping the '\r' of a "\r\n" line ending
+func scanRawLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[0:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// TabWidth is the tab stop Normalize expands tabs to
+const TabWidth = 8
+
+var bom = []byte{0xEF, 0xBB, 0xBF}
+
+// Normalization records what Normalize changed in an input
+type Normalization struct {
+	// BOM is set if a UTF-8 byte order mark was removed from the start of the input
+	BOM bool
+	// CRLF is the number of "\r\n" line endings converted to "\n"
+	CRLF int
+	// TrailingBlankLines is the number of blank lines removed from the end of the input
+	TrailingBlankLines int
+	// Tabs is the number of tabs expanded to spaces
+	Tabs int
+	// MissingFinalNewline is set if a "\n" was added to the end of the last line
+	MissingFinalNewline bool
+}
+
+// Changed reports whether Normalize modified the input at all
+func (n Normalization) Changed() bool {
+	return n != Normalization{}
+}
+
+func (n Normalization) String() string {
+	if !n.Changed() {
+		return "unchanged"
+	}
+	var changes []string
+	if n.BOM {
+		changes = append(changes, "removed BOM")
+	}
+	if n.CRLF > 0 {
+		changes = append(changes, fmt.Sprintf("converted %d CRLF line endings", n.CRLF))
+	}
+	if n.Tabs > 0 {
+		changes = append(changes, fmt.Sprintf("expanded %d tabs", n.Tabs))
+	}
+	if n.TrailingBlankLines > 0 {
+		changes = append(changes, fmt.Sprintf("removed %d trailing blank lines", n.TrailingBlankLines))
+	}
+	if n.MissingFinalNewline {
+		changes = append(changes, "added final newline")
+	}
+	return strings.Join(changes, ", ")
+}
+
+// Normalize removes a leading BOM, converts CRLF to LF, expands tabs to TabWidth, drops trailing
+// blank lines and ensures the input ends in a single newline. It reports what it changed so the
+// right LineMode can be picked for a puzzle. Leading and trailing spaces are left alone.
+func Normalize(b []byte) ([]byte, Normalization) {
+	var n Normalization
+	if bytes.HasPrefix(b, bom) {
+		n.BOM = true
+		b = b[len(bom):]
+	}
+
+	out := make([]byte, 0, len(b)+1)
+	col := 0
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; {
+		case c == '\r' && i+1 < len(b) && b[i+1] == '\n':
+			n.CRLF++
+		case c == '\t':
+			n.Tabs++
+			spaces := TabWidth - col%TabWidth
+			for range spaces {
+				out = append(out, ' ')
+			}
+			col += spaces
+		case c == '\n':
+			out = append(out, c)
+			col = 0
+		default:
+			out = append(out, c)
+			col++
+		}
+	}
+
+	if len(out) > 0 && out[len(out)-1] != '\n' {
+		n.MissingFinalNewline = true
+		out = append(out, '\n')
+	}
+	// a blank line is an empty line before the final newline, or one made up only of spaces
+	for len(out) > 0 {
+		body := out[:len(out)-1]
+		start := bytes.LastIndexByte(body, '\n') + 1
+		if len(bytes.TrimSpace(body[start:])) != 0 {
+			break
+		}
+		n.TrailingBlankLines++
+		out = out[:start]
+	}
+	return out, n
+}
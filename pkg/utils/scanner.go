@@ -0,0 +1,124 @@
+package utils
+
+// ByteScanner reads integers, digits and delimiters straight out of a []byte without allocating
+// or building intermediate strings. Each read returns false, without moving, if the next bytes
+// aren't what was asked for.
+type ByteScanner struct {
+	buf []byte
+	pos int
+}
+
+// NewByteScanner scans b from the start
+func NewByteScanner(b []byte) *ByteScanner {
+	return &ByteScanner{buf: b}
+}
+
+// Reset starts scanning b, so one ByteScanner can be reused across lines
+func (s *ByteScanner) Reset(b []byte) {
+	s.buf = b
+	s.pos = 0
+}
+
+// Pos is the 0-indexed offset of the next byte to be read
+func (s *ByteScanner) Pos() int {
+	return s.pos
+}
+
+// Done reports whether every byte has been read
+func (s *ByteScanner) Done() bool {
+	return s.pos >= len(s.buf)
+}
+
+// Peek returns the next byte without reading it
+func (s *ByteScanner) Peek() (byte, bool) {
+	if s.Done() {
+		return 0, false
+	}
+	return s.buf[s.pos], true
+}
+
+// SkipSpace reads past any spaces, tabs, '\r' or '\n'
+func (s *ByteScanner) SkipSpace() {
+	for s.pos < len(s.buf) {
+		switch s.buf[s.pos] {
+		case ' ', '\t', '\r', '\n':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// Expect reads c if it's the next byte
+func (s *ByteScanner) Expect(c byte) bool {
+	if s.pos < len(s.buf) && s.buf[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// Until returns the bytes up to, but not including, the next c (or the end) and reads past c.
+// The slice aliases the scanned buffer.
+func (s *ByteScanner) Until(c byte) []byte {
+	start := s.pos
+	for s.pos < len(s.buf) && s.buf[s.pos] != c {
+		s.pos++
+	}
+	token := s.buf[start:s.pos]
+	s.Expect(c)
+	return token
+}
+
+// Digit reads a single decimal digit
+func (s *ByteScanner) Digit() (int, bool) {
+	if s.pos < len(s.buf) && isDigit(s.buf[s.pos]) {
+		s.pos++
+		return int(s.buf[s.pos-1] - '0'), true
+	}
+	return 0, false
+}
+
+// Uint reads an unsigned decimal integer, failing if it overflows a uint64
+func (s *ByteScanner) Uint() (uint64, bool) {
+	end := s.pos
+	var n uint64
+	for end < len(s.buf) && isDigit(s.buf[end]) {
+		d := uint64(s.buf[end] - '0')
+		if n > (1<<64-1-d)/10 {
+			return 0, false
+		}
+		n = n*10 + d
+		end++
+	}
+	if end == s.pos {
+		return 0, false
+	}
+	s.pos = end
+	return n, true
+}
+
+// Int reads a decimal integer with an optional leading '-' or '+', failing if it overflows an int
+func (s *ByteScanner) Int() (int, bool) {
+	start := s.pos
+	neg := false
+	if s.Expect('-') {
+		neg = true
+	} else {
+		s.Expect('+')
+	}
+	u, ok := s.Uint()
+	const maxInt = uint64(^uint(0) >> 1)
+	if !ok || (!neg && u > maxInt) || (neg && u > maxInt+1) {
+		s.pos = start
+		return 0, false
+	}
+	if neg {
+		return int(-u), true
+	}
+	return int(u), true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestByteScanner(t *testing.T) {
+	s := NewByteScanner([]byte("11-22, -95+115 9x"))
+
+	lo, ok := s.Uint()
+	require.True(t, ok)
+	require.True(t, s.Expect('-'))
+	hi, ok := s.Uint()
+	require.True(t, ok)
+	require.Equal(t, []uint64{11, 22}, []uint64{lo, hi})
+
+	require.False(t, s.Expect('-'))
+	require.True(t, s.Expect(','))
+	s.SkipSpace()
+
+	a, ok := s.Int()
+	require.True(t, ok)
+	b, ok := s.Int()
+	require.True(t, ok)
+	require.Equal(t, []int{-95, 115}, []int{a, b})
+
+	s.SkipSpace()
+	d, ok := s.Digit()
+	require.True(t, ok)
+	require.Equal(t, 9, d)
+
+	_, ok = s.Int()
+	require.False(t, ok)
+	c, ok := s.Peek()
+	require.True(t, ok)
+	require.Equal(t, byte('x'), c)
+	require.Equal(t, 16, s.Pos())
+	require.Equal(t, "x", string(s.Until(',')))
+	require.True(t, s.Done())
+}
+
+func TestByteScannerOverflow(t *testing.T) {
+	s := NewByteScanner([]byte("18446744073709551615 18446744073709551616"))
+	u, ok := s.Uint()
+	require.True(t, ok)
+	require.Equal(t, uint64(math.MaxUint64), u)
+	s.SkipSpace()
+	_, ok = s.Uint()
+	require.False(t, ok)
+
+	s.Reset([]byte("-9223372036854775808 9223372036854775808"))
+	i, ok := s.Int()
+	require.True(t, ok)
+	require.Equal(t, math.MinInt64, i)
+	s.SkipSpace()
+	pos := s.Pos()
+	_, ok = s.Int()
+	require.False(t, ok)
+	require.Equal(t, pos, s.Pos(), "failed reads don't move the scanner")
+}
+
+func TestByteScannerDoesNotAllocate(t *testing.T) {
+	line := []byte("1188511880-1188511890,222220-222224")
+	s := NewByteScanner(nil)
+	allocs := testing.AllocsPerRun(100, func() {
+		s.Reset(line)
+		for !s.Done() {
+			s.Uint()
+			s.Expect('-')
+			s.Uint()
+			s.Expect(',')
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+// day2 style input: one long line of comma separated id ranges
+var benchRanges = func() []byte {
+	var b strings.Builder
+	for i := range 1000 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "%d-%d", i*1000003, i*1000003+999)
+	}
+	return []byte(b.String())
+}()
+
+// day3 style input: a bank of battery digits
+var benchDigits = []byte(strings.Repeat("2342342342342781", 64))
+
+func BenchmarkRangesSplitAtoi(b *testing.B) {
+	b.ReportAllocs()
+	line := string(benchRanges)
+	for b.Loop() {
+		var sum int
+		for _, idRange := range strings.Split(line, ",") {
+			split := strings.Split(idRange, "-")
+			f, _ := strconv.Atoi(split[0])
+			l, _ := strconv.Atoi(split[1])
+			sum += l - f
+		}
+	}
+}
+
+func BenchmarkRangesByteScanner(b *testing.B) {
+	b.ReportAllocs()
+	s := NewByteScanner(nil)
+	for b.Loop() {
+		var sum int
+		s.Reset(benchRanges)
+		for !s.Done() {
+			f, _ := s.Int()
+			s.Expect('-')
+			l, _ := s.Int()
+			s.Expect(',')
+			sum += l - f
+		}
+	}
+}
+
+func BenchmarkDigitsSubtract(b *testing.B) {
+	b.ReportAllocs()
+	line := string(benchDigits)
+	nums := make([]int, 0, len(line))
+	for b.Loop() {
+		nums = nums[:0]
+		for j := range line {
+			nums = append(nums, int(line[j]-48))
+		}
+	}
+}
+
+func BenchmarkDigitsByteScanner(b *testing.B) {
+	b.ReportAllocs()
+	s := NewByteScanner(nil)
+	nums := make([]int, 0, len(benchDigits))
+	for b.Loop() {
+		nums = nums[:0]
+		s.Reset(benchDigits)
+		for !s.Done() {
+			d, _ := s.Digit()
+			nums = append(nums, d)
+		}
+	}
+}
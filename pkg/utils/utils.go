@@ -1,57 +1,85 @@
 package utils
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"os"
 	"strconv"
-	"strings"
 )
 
-func ReadFileAsLines(path string) []string {
-	f, err := os.Open(path)
+// ReadLines reads every line from r, trimming surrounding whitespace
+func ReadLines(r io.Reader) ([]string, error) {
+	return ReadLinesMode(r, Trim)
+}
+
+// ReadLinesFS reads every line of the named file in fsys, see ReadLines
+func ReadLinesFS(fsys fs.FS, name string) ([]string, error) {
+	f, err := fsys.Open(name)
 	if err != nil {
-		log.Fatal("Could not open file", err)
+		return nil, err
 	}
 	defer f.Close()
-	fmt.Println(f.Name())
-
-	scanner := bufio.NewScanner(f)
-	var lines []string
-	for scanner.Scan() {
-		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	lines, err := ReadLines(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
 	}
+	return lines, nil
+}
+
+// ReadBytes reads the whole of r
+func ReadBytes(r io.Reader) ([]byte, error) {
+	return io.ReadAll(r)
+}
+
+// ReadBytesFS reads the whole of the named file in fsys
+func ReadBytesFS(fsys fs.FS, name string) ([]byte, error) {
+	return fs.ReadFile(fsys, name)
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal("scanner error", err)
+// ParseInts converts every line to an int, reporting the first line (1-indexed) that isn't one
+func ParseInts(input []string) ([]int, error) {
+	result := make([]int, len(input))
+	for i, s := range input {
+		x, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %q: %w", i+1, s, err)
+		}
+		result[i] = x
 	}
-	return lines
+	return result, nil
 }
 
-func ReadFile(path string) []byte {
+// ReadFileAsLines is ReadLines on the file at path, exiting if it can't be read
+func ReadFileAsLines(path string) []string {
 	f, err := os.Open(path)
 	if err != nil {
 		log.Fatal("Could not open file", err)
 	}
 	defer f.Close()
-	fmt.Println(f.Name())
-	b, err := io.ReadAll(f)
+
+	lines, err := ReadLines(f)
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	return lines
+}
+
+// ReadFile is ReadBytes on the file at path, exiting if it can't be read
+func ReadFile(path string) []byte {
+	b, err := os.ReadFile(path)
 	if err != nil {
 		log.Fatal("read all error:", err)
 	}
 	return b
 }
 
+// SliceAtoi is ParseInts, exiting if any line isn't an int
 func SliceAtoi(input []string) []int {
-	result := make([]int, len(input))
-	for i, s := range input {
-		x, err := strconv.Atoi(s)
-		if err != nil {
-			log.Fatalf("Could not parse line %d: %q", i, s)
-		}
-		result[i] = x
+	result, err := ParseInts(input)
+	if err != nil {
+		log.Fatal(err)
 	}
 	return result
 }
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLines(t *testing.T) {
+	lines, err := ReadLines(strings.NewReader("L68\n  L30 \nR48"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"L68", "L30", "R48"}, lines)
+}
+
+func TestReadLinesTooLong(t *testing.T) {
+	input := "ok\n" + strings.Repeat("1", bufio.MaxScanTokenSize+1)
+	_, err := ReadLines(strings.NewReader(input))
+	require.ErrorIs(t, err, bufio.ErrTooLong)
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestReadLinesFS(t *testing.T) {
+	fsys := fstest.MapFS{"day1/test-input": {Data: []byte("L68\nL30\n")}}
+
+	lines, err := ReadLinesFS(fsys, "day1/test-input")
+	require.NoError(t, err)
+	require.Equal(t, []string{"L68", "L30"}, lines)
+
+	_, err = ReadLinesFS(fsys, "day1/input")
+	require.Error(t, err)
+}
+
+func TestParseInts(t *testing.T) {
+	ints, err := ParseInts([]string{"1", "-5", "32"})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, -5, 32}, ints)
+
+	_, err = ParseInts([]string{"1", "x"})
+	require.ErrorContains(t, err, `line 2: "x"`)
+}
+
+func TestReadLinesMode(t *testing.T) {
+	input := "    [D]    \r\n[N] [C]    \r\n 1   2   3"
+
+	lines, err := ReadLinesMode(strings.NewReader(input), Raw)
+	require.NoError(t, err)
+	require.Equal(t, []string{"    [D]    \r", "[N] [C]    \r", " 1   2   3"}, lines)
+
+	lines, err = ReadLinesMode(strings.NewReader(input), TrimRight)
+	require.NoError(t, err)
+	require.Equal(t, []string{"    [D]", "[N] [C]", " 1   2   3"}, lines)
+
+	lines, err = ReadLinesMode(strings.NewReader(input), Trim)
+	require.NoError(t, err)
+	require.Equal(t, []string{"[D]", "[N] [C]", "1   2   3"}, lines)
+}
+
+func TestNormalize(t *testing.T) {
+	input := "\xEF\xBB\xBFa\tb\r\n  c\r\n\n \n"
+
+	out, n := Normalize([]byte(input))
+	require.Equal(t, "a       b\n  c\n", string(out))
+	require.Equal(t, Normalization{BOM: true, CRLF: 2, Tabs: 1, TrailingBlankLines: 2}, n)
+
+	out, n = Normalize([]byte("3-5\n\n1"))
+	require.Equal(t, "3-5\n\n1\n", string(out))
+	require.Equal(t, Normalization{MissingFinalNewline: true}, n)
+	require.Equal(t, "added final newline", n.String())
+
+	_, n = Normalize(out)
+	require.False(t, n.Changed())
+}
+
+func TestLineReader(t *testing.T) {
+	long := strings.Repeat("11-22,", bufio.MaxScanTokenSize)
+	lr := NewLineReader(strings.NewReader("a \n"+long+"\n\n c"), Trim, LongLine)
+
+	var numbers []int
+	var lengths []int
+	for n, line := range lr.Bytes() {
+		numbers = append(numbers, n)
+		lengths = append(lengths, len(line))
+	}
+	require.NoError(t, lr.Err())
+	require.Equal(t, []int{1, 2, 3, 4}, numbers)
+	require.Equal(t, []int{1, len(long), 0, 1}, lengths)
+
+	lr = NewLineReader(strings.NewReader("a\n"+long), Trim, DefaultMaxLine)
+	for range lr.Lines() {
+	}
+	require.ErrorIs(t, lr.Err(), bufio.ErrTooLong)
+	require.ErrorContains(t, lr.Err(), "line 2")
+}
+
+func TestLineReaderStopsEarly(t *testing.T) {
+	lr := NewLineReader(strings.NewReader("a\nb\nc\n"), Raw, DefaultMaxLine)
+	var lines []string
+	for _, line := range lr.Lines() {
+		lines = append(lines, line)
+		if line == "b" {
+			break
+		}
+	}
+	require.Equal(t, []string{"a", "b"}, lines)
+}